@@ -0,0 +1,24 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// runConformance implements "uuid conformance", writing the conformance
+// suite as JSON to stdout
+func runConformance(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("conformance", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+    if err := uuid.WriteConformance(stdout); err != nil {
+        fmt.Fprintf(stderr, "uuid conformance: %v\n", err)
+        return 1
+    }
+    return 0
+}
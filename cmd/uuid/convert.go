@@ -0,0 +1,45 @@
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// runConvert implements "uuid convert -from <format> -to <format>". Invalid
+// records are reported on stderr and make the command exit with status 1.
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+    fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    from := fs.String("from", "canonical", "input format: canonical, hex, base58, ulid or binary")
+    to := fs.String("to", "canonical", "output format: canonical, hex, base58, ulid or binary")
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+
+    in, err := uuid.ParseFormat(*from)
+    if err != nil {
+        fmt.Fprintf(stderr, "uuid convert: %v\n", err)
+        return 2
+    }
+    out, err := uuid.ParseFormat(*to)
+    if err != nil {
+        fmt.Fprintf(stderr, "uuid convert: %v\n", err)
+        return 2
+    }
+
+    report, err := uuid.ConvertAll(stdin, in, out, stdout)
+    for _, e := range report.Errors {
+        fmt.Fprintf(stderr, "uuid convert: %v\n", e)
+    }
+    if err != nil {
+        fmt.Fprintf(stderr, "uuid convert: %v\n", err)
+        return 1
+    }
+    if len(report.Errors) > 0 {
+        return 1
+    }
+    return 0
+}
@@ -0,0 +1,43 @@
+// Command uuid is a command-line front end to the uuid package
+//
+// Usage:
+//
+//	uuid <command> [flags]
+//
+// Commands:
+//
+//	convert      convert UUIDs read from stdin between representations
+//	conformance  print the cross-language conformance suite as JSON
+package main
+
+import (
+    "fmt"
+    "io"
+    "os"
+)
+
+// command is a subcommand taking its arguments and standard streams and
+// returning the exit status
+type command func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+    "convert":     runConvert,
+    "conformance": runConformance,
+}
+
+func main() {
+    os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+    if len(args) == 0 {
+        fmt.Fprintln(stderr, "usage: uuid <command> [flags]")
+        return 2
+    }
+    cmd, ok := commands[args[0]]
+    if !ok {
+        fmt.Fprintf(stderr, "uuid: unknown command %q\n", args[0])
+        return 2
+    }
+    return cmd(args[1:], stdin, stdout, stderr)
+}
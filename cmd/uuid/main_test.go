@@ -0,0 +1,45 @@
+package main
+
+import (
+    "bytes"
+    "encoding/json"
+    "strings"
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+)
+
+func TestRunUnknownCommand(t *testing.T) {
+    var stdout, stderr bytes.Buffer
+    assert.Equal(t, 2, run(nil, nil, &stdout, &stderr))
+    assert.Equal(t, 2, run([]string{"nope"}, nil, &stdout, &stderr))
+    assert.Contains(t, stderr.String(), `unknown command "nope"`)
+}
+
+func TestRunConvert(t *testing.T) {
+    var stdout, stderr bytes.Buffer
+    in := strings.NewReader("550e8400-e29b-41d4-a716-446655440000\n")
+    code := run([]string{"convert", "-to", "hex"}, in, &stdout, &stderr)
+    assert.Equal(t, 0, code)
+    assert.Equal(t, "550e8400e29b41d4a716446655440000\n", stdout.String())
+    assert.Empty(t, stderr.String())
+}
+
+func TestRunConvertErrors(t *testing.T) {
+    var stdout, stderr bytes.Buffer
+    in := strings.NewReader("bogus\n")
+    assert.Equal(t, 1, run([]string{"convert", "-from", "base58"}, in, &stdout, &stderr))
+    assert.Contains(t, stderr.String(), "record 1")
+
+    assert.Equal(t, 2, run([]string{"convert", "-to", "base64"}, in, &stdout, &stderr))
+}
+
+func TestRunConformance(t *testing.T) {
+    var stdout, stderr bytes.Buffer
+    assert.Equal(t, 0, run([]string{"conformance"}, nil, &stdout, &stderr))
+
+    var suite uuid.ConformanceSuite
+    assert.NoError(t, json.Unmarshal(stdout.Bytes(), &suite))
+    assert.Equal(t, uuid.ConformanceSchema, suite.Schema)
+}
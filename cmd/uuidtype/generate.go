@@ -0,0 +1,167 @@
+package main
+
+import (
+    "bytes"
+    "fmt"
+    "go/format"
+    "go/token"
+    "text/template"
+)
+
+var typesTemplate = template.Must(template.New("types").Parse(`// Code generated by uuidtype; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+
+	"github.com/Wembie/uuid/pkg/uuid"
+)
+{{range .Names}}
+// {{.}}ID identifies a {{.}}
+type {{.}}ID uuid.UUID
+
+// New{{.}}ID generates a {{.}}ID with the default generator
+func New{{.}}ID() {{.}}ID {
+	return {{.}}ID(uuid.New())
+}
+
+// Parse{{.}}ID parses s into a {{.}}ID
+func Parse{{.}}ID(s string) ({{.}}ID, error) {
+	u, err := uuid.Parse(s)
+	return {{.}}ID(u), err
+}
+
+// UUID returns the untyped UUID
+func (id {{.}}ID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// IsNil returns true if the ID is the nil UUID
+func (id {{.}}ID) IsNil() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// String returns the canonical string form of the ID
+func (id {{.}}ID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (id {{.}}ID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (id *{{.}}ID) UnmarshalText(text []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler
+func (id {{.}}ID) MarshalJSON() ([]byte, error) {
+	return uuid.UUID(id).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (id *{{.}}ID) UnmarshalJSON(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalJSON(data)
+}
+
+// Value implements driver.Valuer
+func (id {{.}}ID) Value() (driver.Value, error) {
+	return uuid.UUID(id).Value()
+}
+
+// Scan implements sql.Scanner
+func (id *{{.}}ID) Scan(value interface{}) error {
+	return (*uuid.UUID)(id).Scan(value)
+}
+{{end}}`))
+
+var testsTemplate = template.Must(template.New("tests").Parse(`// Code generated by uuidtype; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"testing"
+)
+{{range .Names}}
+func Test{{.}}ID(t *testing.T) {
+	id := New{{.}}ID()
+	if id.IsNil() {
+		t.Fatal("New{{.}}ID returned the nil ID")
+	}
+
+	parsed, err := Parse{{.}}ID(id.String())
+	if err != nil || parsed != id {
+		t.Fatalf("Parse{{.}}ID(%q) = %v, %v", id.String(), parsed, err)
+	}
+
+	data, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON {{.}}ID
+	if err := json.Unmarshal(data, &fromJSON); err != nil || fromJSON != id {
+		t.Fatalf("JSON round trip of %v = %v, %v", id, fromJSON, err)
+	}
+
+	text, err := id.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromText {{.}}ID
+	if err := fromText.UnmarshalText(text); err != nil || fromText != id {
+		t.Fatalf("text round trip of %v = %v, %v", id, fromText, err)
+	}
+
+	value, err := id.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scanned {{.}}ID
+	if err := scanned.Scan(value); err != nil || scanned != id {
+		t.Fatalf("SQL round trip of %v = %v, %v", id, scanned, err)
+	}
+}
+{{end}}`))
+
+// templateData is the input of both templates
+type templateData struct {
+    Package string
+    Names   []string
+}
+
+// generate returns the formatted source declaring an ID type per name
+func generate(pkg string, names []string) ([]byte, error) {
+    return execute(typesTemplate, pkg, names)
+}
+
+// generateTests returns the formatted source of tests for the types
+// declared by generate
+func generateTests(pkg string, names []string) ([]byte, error) {
+    return execute(testsTemplate, pkg, names)
+}
+
+func execute(tmpl *template.Template, pkg string, names []string) ([]byte, error) {
+    if !token.IsIdentifier(pkg) {
+        return nil, fmt.Errorf("invalid package name %q", pkg)
+    }
+    seen := make(map[string]bool)
+    for _, name := range names {
+        if !token.IsIdentifier(name) || !token.IsExported(name) {
+            return nil, fmt.Errorf("invalid entity name %q: must be an exported Go identifier", name)
+        }
+        if seen[name] {
+            return nil, fmt.Errorf("duplicate entity name %q", name)
+        }
+        seen[name] = true
+    }
+
+    var buf bytes.Buffer
+    if err := tmpl.Execute(&buf, templateData{Package: pkg, Names: names}); err != nil {
+        return nil, err
+    }
+    return format.Source(buf.Bytes())
+}
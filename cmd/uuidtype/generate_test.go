@@ -0,0 +1,64 @@
+package main
+
+import (
+    "bytes"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+    src, err := generate("models", []string{"User", "Order"})
+    require.NoError(t, err)
+    s := string(src)
+    assert.True(t, strings.HasPrefix(s, "// Code generated by uuidtype; DO NOT EDIT."))
+    assert.Contains(t, s, "package models")
+    assert.Contains(t, s, "type UserID uuid.UUID")
+    assert.Contains(t, s, "func NewOrderID() OrderID")
+    assert.Contains(t, s, "func (id *OrderID) Scan(value interface{}) error")
+}
+
+func TestGenerateInvalid(t *testing.T) {
+    for _, names := range [][]string{{"user"}, {"User", "User"}, {"Us-er"}, {""}} {
+        _, err := generate("models", names)
+        assert.Error(t, err, names)
+    }
+    _, err := generate("not a package", []string{"User"})
+    assert.Error(t, err)
+}
+
+// TestGeneratedCodeCompiles runs the generated tests against the generated
+// types in a throwaway package inside this module
+func TestGeneratedCodeCompiles(t *testing.T) {
+    if testing.Short() {
+        t.Skip("runs the go tool")
+    }
+    goTool, err := exec.LookPath("go")
+    if err != nil {
+        t.Skip("go tool not found")
+    }
+
+    dir, err := os.MkdirTemp(".", "gentest")
+    require.NoError(t, err)
+    t.Cleanup(func() { os.RemoveAll(dir) })
+
+    var stderr bytes.Buffer
+    output := filepath.Join(dir, "ids_gen.go")
+    code := run([]string{"-package", "gentest", "-output", output, "-tests", "User", "Order"}, &stderr)
+    require.Equal(t, 0, code, stderr.String())
+
+    cmd := exec.Command(goTool, "test", "./"+filepath.Base(dir))
+    out, err := cmd.CombinedOutput()
+    require.NoError(t, err, string(out))
+}
+
+func TestRunUsage(t *testing.T) {
+    var stderr bytes.Buffer
+    assert.Equal(t, 2, run([]string{"-package", "models"}, &stderr))
+    assert.Contains(t, stderr.String(), "usage")
+}
@@ -0,0 +1,60 @@
+// Command uuidtype generates typed ID declarations backed by uuid.UUID, so
+// that IDs of different entities are distinct types. For each entity name
+// it emits a <Name>ID type with a constructor, a parser and text, JSON and
+// SQL implementations, and optionally a test file exercising them.
+//
+// Usage with go:generate:
+//
+//	//go:generate go run github.com/Wembie/uuid/cmd/uuidtype -output ids_gen.go -tests User Order
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "strings"
+)
+
+func main() {
+    os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+func run(args []string, stderr io.Writer) int {
+    fs := flag.NewFlagSet("uuidtype", flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    pkg := fs.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file; defaults to $GOPACKAGE")
+    output := fs.String("output", "uuidtype_gen.go", "output file name")
+    tests := fs.Bool("tests", false, "also generate a _test.go file next to the output")
+    if err := fs.Parse(args); err != nil {
+        return 2
+    }
+    if *pkg == "" || fs.NArg() == 0 {
+        fmt.Fprintln(stderr, "usage: uuidtype -package <name> [-output file] [-tests] Entity...")
+        return 2
+    }
+
+    src, err := generate(*pkg, fs.Args())
+    if err != nil {
+        fmt.Fprintf(stderr, "uuidtype: %v\n", err)
+        return 1
+    }
+    if err := os.WriteFile(*output, src, 0o644); err != nil {
+        fmt.Fprintf(stderr, "uuidtype: %v\n", err)
+        return 1
+    }
+
+    if *tests {
+        src, err := generateTests(*pkg, fs.Args())
+        if err != nil {
+            fmt.Fprintf(stderr, "uuidtype: %v\n", err)
+            return 1
+        }
+        name := strings.TrimSuffix(*output, ".go") + "_test.go"
+        if err := os.WriteFile(name, src, 0o644); err != nil {
+            fmt.Fprintf(stderr, "uuidtype: %v\n", err)
+            return 1
+        }
+    }
+    return 0
+}
@@ -0,0 +1,100 @@
+// Package entuuid provides ent schema helpers for UUID fields backed by
+// github.com/Wembie/uuid. uuid.UUID implements field.ValueScanner, so it
+// can be used with field.UUID directly; the helpers here add generated
+// defaults, a mixin for primary keys and a ValueScanner that stores UUIDs
+// as 16 bytes.
+//
+//	func (User) Mixin() []ent.Mixin {
+//	    return []ent.Mixin{entuuid.V7IDMixin{}}
+//	}
+package entuuid
+
+import (
+    "database/sql/driver"
+    "fmt"
+
+    "entgo.io/ent"
+    "entgo.io/ent/schema/field"
+    "entgo.io/ent/schema/mixin"
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+func init() {
+    uuid.RegisterIntegration("ent")
+}
+
+var _ field.ValueScanner = (*uuid.UUID)(nil)
+
+// v7Generator backs NewV7
+var v7Generator = uuid.MustNewGenerator(uuid.VersionUnixTime)
+
+// NewV7 generates a time-ordered V7 UUID, for use as a field default. It
+// returns the nil UUID if generation fails, like uuid.New.
+func NewV7() uuid.UUID {
+    u, _ := v7Generator.Generate()
+    return u
+}
+
+// ID returns an immutable "id" field defaulting to a random V4 UUID
+func ID() ent.Field {
+    return field.UUID("id", uuid.UUID{}).
+        Default(uuid.New).
+        Immutable()
+}
+
+// V7ID returns an immutable "id" field defaulting to a time-ordered V7
+// UUID, which keeps B-tree primary key inserts sequential
+func V7ID() ent.Field {
+    return field.UUID("id", uuid.UUID{}).
+        Default(NewV7).
+        Immutable()
+}
+
+// IDMixin declares a V4 UUID primary key, see ID
+type IDMixin struct {
+    mixin.Schema
+}
+
+// Fields implements ent.Mixin
+func (IDMixin) Fields() []ent.Field {
+    return []ent.Field{ID()}
+}
+
+// V7IDMixin declares a V7 UUID primary key, see V7ID
+type V7IDMixin struct {
+    mixin.Schema
+}
+
+// Fields implements ent.Mixin
+func (V7IDMixin) Fields() []ent.Field {
+    return []ent.Field{V7ID()}
+}
+
+// BinaryValueScanner stores a uuid.UUID as 16 raw bytes, for databases
+// without a native UUID type:
+//
+//	field.Bytes("token").
+//	    GoType(uuid.UUID{}).
+//	    ValueScanner(entuuid.BinaryValueScanner{})
+type BinaryValueScanner struct{}
+
+var _ field.TypeValueScanner[uuid.UUID] = BinaryValueScanner{}
+
+// Value implements field.TypeValueScanner
+func (BinaryValueScanner) Value(u uuid.UUID) (driver.Value, error) {
+    return u.ValueAs(uuid.ValueBytes)
+}
+
+// ScanValue implements field.TypeValueScanner
+func (BinaryValueScanner) ScanValue() field.ValueScanner {
+    return &uuid.NullUUID{}
+}
+
+// FromValue implements field.TypeValueScanner
+func (BinaryValueScanner) FromValue(v driver.Value) (uuid.UUID, error) {
+    n, ok := v.(*uuid.NullUUID)
+    if !ok {
+        return uuid.Nil, fmt.Errorf("unexpected input for FromValue: %T", v)
+    }
+    return n.UUID, nil
+}
@@ -0,0 +1,66 @@
+package entuuid
+
+import (
+    "testing"
+
+    "entgo.io/ent/schema/field"
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestIDFields(t *testing.T) {
+    for name, f := range map[string]*field.Descriptor{
+        "ID":   ID().Descriptor(),
+        "V7ID": V7ID().Descriptor(),
+    } {
+        require.NoError(t, f.Err, name)
+        assert.Equal(t, "id", f.Name, name)
+        assert.Equal(t, field.TypeUUID, f.Info.Type, name)
+        assert.Equal(t, "uuid.UUID", f.Info.Ident, name)
+        assert.True(t, f.Immutable, name)
+        require.IsType(t, func() uuid.UUID { return uuid.Nil }, f.Default, name)
+    }
+
+    assert.Equal(t, uuid.VersionRandom, ID().Descriptor().Default.(func() uuid.UUID)().Version())
+    assert.Equal(t, uuid.VersionUnixTime, V7ID().Descriptor().Default.(func() uuid.UUID)().Version())
+}
+
+func TestMixins(t *testing.T) {
+    fields := IDMixin{}.Fields()
+    require.Len(t, fields, 1)
+    assert.Equal(t, "id", fields[0].Descriptor().Name)
+
+    fields = V7IDMixin{}.Fields()
+    require.Len(t, fields, 1)
+    assert.Equal(t, uuid.VersionUnixTime, fields[0].Descriptor().Default.(func() uuid.UUID)().Version())
+}
+
+func TestBinaryValueScanner(t *testing.T) {
+    d := field.Bytes("token").
+        GoType(uuid.UUID{}).
+        ValueScanner(BinaryValueScanner{}).
+        Descriptor()
+    require.NoError(t, d.Err)
+
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+    var vs BinaryValueScanner
+    v, err := vs.Value(u)
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+
+    scan := vs.ScanValue()
+    require.NoError(t, scan.Scan(v))
+    got, err := vs.FromValue(scan)
+    require.NoError(t, err)
+    assert.Equal(t, u, got)
+
+    scan = vs.ScanValue()
+    require.NoError(t, scan.Scan(nil))
+    got, err = vs.FromValue(scan)
+    require.NoError(t, err)
+    assert.Equal(t, uuid.Nil, got)
+
+    _, err = vs.FromValue("nope")
+    assert.Error(t, err)
+}
@@ -0,0 +1,17 @@
+module github.com/Wembie/uuid/pkg/entuuid
+
+go 1.23.2
+
+require (
+	entgo.io/ent v0.14.1
+	github.com/Wembie/uuid v0.0.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/Wembie/uuid => ../..
@@ -0,0 +1,20 @@
+module github.com/Wembie/uuid/pkg/gormuuid
+
+go 1.23.2
+
+require (
+	github.com/Wembie/uuid v0.0.0
+	github.com/stretchr/testify v1.10.0
+	gorm.io/gorm v1.25.12
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/Wembie/uuid => ../..
@@ -0,0 +1,178 @@
+// Package gormuuid maps UUIDs to the native column type of each GORM
+// dialect. Use UUID or BinaryUUID as model field types:
+//
+//	type User struct {
+//	    ID    gormuuid.UUID `gorm:"primaryKey"`
+//	    Token gormuuid.BinaryUUID
+//	}
+//
+// or keep uuid.UUID fields and store them as 16 bytes with the serializer
+// registered by this package, `gorm:"serializer:uuid_binary"`.
+package gormuuid
+
+import (
+    "context"
+    "database/sql/driver"
+    "fmt"
+    "reflect"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "gorm.io/gorm"
+    "gorm.io/gorm/schema"
+)
+
+// UUID is stored in the dialect's UUID or text column type: uuid on
+// Postgres, uniqueidentifier on SQL Server, char(36) on MySQL and text on
+// SQLite and others
+type UUID uuid.UUID
+
+// BinaryUUID is stored as 16 raw bytes: bytea on Postgres, binary(16) on
+// MySQL and SQL Server and blob on SQLite and others
+type BinaryUUID uuid.UUID
+
+// GormDataType implements schema.GormDataTypeInterface
+func (UUID) GormDataType() string {
+    return "uuid"
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface
+func (UUID) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+    switch db.Dialector.Name() {
+    case "postgres":
+        return "uuid"
+    case "sqlserver":
+        return "uniqueidentifier"
+    case "mysql":
+        return "char(36)"
+    default:
+        return "text"
+    }
+}
+
+// String returns the canonical string form
+func (u UUID) String() string {
+    return uuid.UUID(u).String()
+}
+
+// Value implements driver.Valuer
+func (u UUID) Value() (driver.Value, error) {
+    return uuid.UUID(u).ValueAs(uuid.ValueString)
+}
+
+// Scan implements sql.Scanner. SQL Server drivers return uniqueidentifier
+// columns as 16 bytes with the first three fields little-endian, so 16-byte
+// values are decoded as by uuid.SQLServerUUID, which keeps the bytes of
+// well-formed big-endian UUIDs such as those in legacy binary columns.
+func (u *UUID) Scan(value interface{}) error {
+    return (*uuid.SQLServerUUID)(u).Scan(value)
+}
+
+// MarshalJSON implements json.Marshaler
+func (u UUID) MarshalJSON() ([]byte, error) {
+    return uuid.UUID(u).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (u *UUID) UnmarshalJSON(data []byte) error {
+    return (*uuid.UUID)(u).UnmarshalJSON(data)
+}
+
+// GormDataType implements schema.GormDataTypeInterface
+func (BinaryUUID) GormDataType() string {
+    return "bytes"
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface
+func (BinaryUUID) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+    return binaryColumn(db.Dialector.Name())
+}
+
+// String returns the canonical string form
+func (u BinaryUUID) String() string {
+    return uuid.UUID(u).String()
+}
+
+// Value implements driver.Valuer
+func (u BinaryUUID) Value() (driver.Value, error) {
+    return uuid.UUID(u).ValueAs(uuid.ValueBytes)
+}
+
+// Scan implements sql.Scanner
+func (u *BinaryUUID) Scan(value interface{}) error {
+    return (*uuid.UUID)(u).Scan(value)
+}
+
+// MarshalJSON implements json.Marshaler
+func (u BinaryUUID) MarshalJSON() ([]byte, error) {
+    return uuid.UUID(u).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (u *BinaryUUID) UnmarshalJSON(data []byte) error {
+    return (*uuid.UUID)(u).UnmarshalJSON(data)
+}
+
+func binaryColumn(dialect string) string {
+    switch dialect {
+    case "postgres":
+        return "bytea"
+    case "mysql", "sqlserver":
+        return "binary(16)"
+    default:
+        return "blob"
+    }
+}
+
+func init() {
+    schema.RegisterSerializer("uuid_binary", BinarySerializer{})
+    uuid.RegisterIntegration("gorm")
+}
+
+var (
+    uuidType    = reflect.TypeOf(uuid.UUID{})
+    uuidPtrType = reflect.TypeOf(&uuid.UUID{})
+)
+
+// BinarySerializer stores uuid.UUID and *uuid.UUID fields as 16 raw bytes.
+// It is registered as "uuid_binary".
+type BinarySerializer struct{}
+
+// Scan implements schema.SerializerInterface
+func (BinarySerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+    var u uuid.UUID
+    if dbValue != nil {
+        if err := u.Scan(dbValue); err != nil {
+            return err
+        }
+    }
+
+    target := field.ReflectValueOf(ctx, dst)
+    switch field.FieldType {
+    case uuidType:
+        target.Set(reflect.ValueOf(u))
+    case uuidPtrType:
+        if dbValue == nil {
+            target.Set(reflect.Zero(uuidPtrType))
+        } else {
+            target.Set(reflect.ValueOf(&u))
+        }
+    default:
+        return fmt.Errorf("uuid_binary serializer does not support field type %s", field.FieldType)
+    }
+    return nil
+}
+
+// Value implements schema.SerializerValuerInterface
+func (BinarySerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+    switch v := fieldValue.(type) {
+    case uuid.UUID:
+        return v.ValueAs(uuid.ValueBytes)
+    case *uuid.UUID:
+        if v == nil {
+            return nil, nil
+        }
+        return v.ValueAs(uuid.ValueBytes)
+    default:
+        return nil, fmt.Errorf("uuid_binary serializer does not support %T", fieldValue)
+    }
+}
@@ -0,0 +1,123 @@
+package gormuuid
+
+import (
+    "context"
+    "reflect"
+    "sync"
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+    "gorm.io/gorm"
+    "gorm.io/gorm/schema"
+)
+
+// dialector is a gorm.Dialector that only reports its name
+type dialector struct {
+    gorm.Dialector
+    name string
+}
+
+func (d dialector) Name() string {
+    return d.name
+}
+
+func dbFor(name string) *gorm.DB {
+    return &gorm.DB{Config: &gorm.Config{Dialector: dialector{name: name}}}
+}
+
+func TestGormDBDataType(t *testing.T) {
+    for dialect, want := range map[string][2]string{
+        "postgres":  {"uuid", "bytea"},
+        "mysql":     {"char(36)", "binary(16)"},
+        "sqlserver": {"uniqueidentifier", "binary(16)"},
+        "sqlite":    {"text", "blob"},
+    } {
+        db := dbFor(dialect)
+        assert.Equal(t, want[0], UUID{}.GormDBDataType(db, nil), dialect)
+        assert.Equal(t, want[1], BinaryUUID{}.GormDBDataType(db, nil), dialect)
+    }
+    assert.Equal(t, "uuid", UUID{}.GormDataType())
+    assert.Equal(t, "bytes", BinaryUUID{}.GormDataType())
+}
+
+func TestValueScan(t *testing.T) {
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    v, err := UUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), v)
+    var text UUID
+    require.NoError(t, text.Scan(v))
+    assert.Equal(t, UUID(u), text)
+
+    v, err = BinaryUUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+    var bin BinaryUUID
+    require.NoError(t, bin.Scan(v))
+    assert.Equal(t, BinaryUUID(u), bin)
+
+    data, err := bin.MarshalJSON()
+    require.NoError(t, err)
+    assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, string(data))
+}
+
+func TestScanUniqueIdentifier(t *testing.T) {
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    // The value written is the canonical string, which SQL Server converts
+    v, err := UUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), v)
+
+    // github.com/microsoft/go-mssqldb returns uniqueidentifier columns as
+    // their 16 stored bytes, with the first three fields little-endian
+    stored := []byte{
+        0x00, 0x84, 0x0e, 0x55, 0x9b, 0xe2, 0xd4, 0x41,
+        0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00,
+    }
+    var scanned UUID
+    require.NoError(t, scanned.Scan(stored))
+    assert.Equal(t, UUID(u), scanned)
+
+    // Big-endian bytes from a legacy binary column are kept as they are
+    require.NoError(t, scanned.Scan(u.Bytes()))
+    assert.Equal(t, UUID(u), scanned)
+}
+
+type model struct {
+    ID     uuid.UUID  `gorm:"serializer:uuid_binary"`
+    Parent *uuid.UUID `gorm:"serializer:uuid_binary"`
+}
+
+func TestBinarySerializer(t *testing.T) {
+    s, err := schema.Parse(&model{}, &sync.Map{}, schema.NamingStrategy{})
+    require.NoError(t, err)
+    ctx := context.Background()
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    var m model
+    dst := reflect.ValueOf(&m).Elem()
+    require.NoError(t, BinarySerializer{}.Scan(ctx, s.LookUpField("ID"), dst, u[:]))
+    require.NoError(t, BinarySerializer{}.Scan(ctx, s.LookUpField("Parent"), dst, u[:]))
+    assert.Equal(t, u, m.ID)
+    require.NotNil(t, m.Parent)
+    assert.Equal(t, u, *m.Parent)
+
+    require.NoError(t, BinarySerializer{}.Scan(ctx, s.LookUpField("Parent"), dst, nil))
+    assert.Nil(t, m.Parent)
+
+    v, err := BinarySerializer{}.Value(ctx, s.LookUpField("ID"), dst, u)
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+    v, err = BinarySerializer{}.Value(ctx, s.LookUpField("Parent"), dst, (*uuid.UUID)(nil))
+    require.NoError(t, err)
+    assert.Nil(t, v)
+    _, err = BinarySerializer{}.Value(ctx, s.LookUpField("ID"), dst, "nope")
+    assert.Error(t, err)
+
+    _, ok := schema.GetSerializer("uuid_binary")
+    assert.True(t, ok)
+}
@@ -0,0 +1,21 @@
+module github.com/Wembie/uuid/pkg/pgxuuid
+
+go 1.23.2
+
+require (
+	github.com/Wembie/uuid v0.0.0
+	github.com/jackc/pgx/v5 v5.7.1
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/crypto v0.27.0 // indirect
+	golang.org/x/text v0.18.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/Wembie/uuid => ../..
@@ -0,0 +1,137 @@
+// Package pgxuuid registers uuid.UUID and uuid.NullUUID with pgx v5, so
+// they are encoded and scanned natively in both the binary and text
+// formats instead of going through database/sql string conversions.
+//
+// Register the codec on every connection:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//	    pgxuuid.Register(conn.TypeMap())
+//	    return nil
+//	}
+package pgxuuid
+
+import (
+    "fmt"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/jackc/pgx/v5/pgtype"
+)
+
+func init() {
+    uuid.RegisterIntegration("pgx")
+}
+
+// Register installs the codec for the uuid type in m and maps uuid.UUID
+// and uuid.NullUUID values to it
+func Register(m *pgtype.Map) {
+    m.RegisterType(&pgtype.Type{Name: "uuid", OID: pgtype.UUIDOID, Codec: Codec{}})
+    m.RegisterDefaultPgType(uuid.UUID{}, "uuid")
+    m.RegisterDefaultPgType(uuid.NullUUID{}, "uuid")
+}
+
+// Codec is pgx's UUID codec extended with direct plans for uuid.UUID and
+// uuid.NullUUID. Other Go types are handled by the embedded codec.
+type Codec struct {
+    pgtype.UUIDCodec
+}
+
+// PlanEncode implements pgtype.Codec
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+    switch value.(type) {
+    case uuid.UUID, uuid.NullUUID:
+        if format == pgtype.BinaryFormatCode {
+            return encodeBinary{}
+        }
+        return encodeText{}
+    }
+    return c.UUIDCodec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec
+func (c Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+    switch target.(type) {
+    case *uuid.UUID, *uuid.NullUUID:
+        return scanPlan{format: format}
+    }
+    return c.UUIDCodec.PlanScan(m, oid, format, target)
+}
+
+// DecodeValue implements pgtype.Codec, returning a uuid.UUID
+func (c Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+    if src == nil {
+        return nil, nil
+    }
+    return decode(format, src)
+}
+
+// unwrap returns the UUID of value and whether it is non-NULL
+func unwrap(value any) (uuid.UUID, bool) {
+    switch v := value.(type) {
+    case uuid.UUID:
+        return v, true
+    case uuid.NullUUID:
+        return v.UUID, v.Valid
+    }
+    return uuid.Nil, false
+}
+
+type encodeBinary struct{}
+
+func (encodeBinary) Encode(value any, buf []byte) ([]byte, error) {
+    u, ok := unwrap(value)
+    if !ok {
+        return nil, nil
+    }
+    return append(buf, u[:]...), nil
+}
+
+type encodeText struct{}
+
+func (encodeText) Encode(value any, buf []byte) ([]byte, error) {
+    u, ok := unwrap(value)
+    if !ok {
+        return nil, nil
+    }
+    var text [uuid.EncodedLen]byte
+    u.AppendCanonical(&text)
+    return append(buf, text[:]...), nil
+}
+
+type scanPlan struct {
+    format int16
+}
+
+func (p scanPlan) Scan(src []byte, dst any) error {
+    switch dst := dst.(type) {
+    case *uuid.UUID:
+        if src == nil {
+            return fmt.Errorf("cannot scan NULL into *uuid.UUID")
+        }
+        u, err := decode(p.format, src)
+        if err != nil {
+            return err
+        }
+        *dst = u
+    case *uuid.NullUUID:
+        if src == nil {
+            *dst = uuid.NullUUID{}
+            return nil
+        }
+        u, err := decode(p.format, src)
+        if err != nil {
+            return err
+        }
+        *dst = uuid.NullUUID{UUID: u, Valid: true}
+    default:
+        return fmt.Errorf("cannot scan uuid into %T", dst)
+    }
+    return nil
+}
+
+// decode reads a non-NULL uuid column value in the given format
+func decode(format int16, src []byte) (uuid.UUID, error) {
+    if format == pgtype.BinaryFormatCode {
+        return uuid.ParseBytes(src)
+    }
+    return uuid.Parse(string(src))
+}
@@ -0,0 +1,98 @@
+package pgxuuid
+
+import (
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/jackc/pgx/v5/pgtype"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func newMap() *pgtype.Map {
+    m := pgtype.NewMap()
+    Register(m)
+    return m
+}
+
+func TestEncode(t *testing.T) {
+    m := newMap()
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    buf, err := m.Encode(pgtype.UUIDOID, pgtype.BinaryFormatCode, u, nil)
+    require.NoError(t, err)
+    assert.Equal(t, u[:], buf)
+
+    buf, err = m.Encode(pgtype.UUIDOID, pgtype.TextFormatCode, u, nil)
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), string(buf))
+
+    buf, err = m.Encode(pgtype.UUIDOID, pgtype.BinaryFormatCode, uuid.NullUUID{}, nil)
+    require.NoError(t, err)
+    assert.Nil(t, buf)
+
+    buf, err = m.Encode(pgtype.UUIDOID, pgtype.BinaryFormatCode, uuid.NullUUID{UUID: u, Valid: true}, nil)
+    require.NoError(t, err)
+    assert.Equal(t, u[:], buf)
+}
+
+func TestScan(t *testing.T) {
+    m := newMap()
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    var got uuid.UUID
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:], &got))
+    assert.Equal(t, u, got)
+
+    got = uuid.Nil
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.TextFormatCode, []byte(u.String()), &got))
+    assert.Equal(t, u, got)
+
+    assert.Error(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &got))
+    assert.Error(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:8], &got))
+
+    var null uuid.NullUUID
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &null))
+    assert.False(t, null.Valid)
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:], &null))
+    assert.Equal(t, uuid.NullUUID{UUID: u, Valid: true}, null)
+}
+
+func TestDecodeValue(t *testing.T) {
+    m := newMap()
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+    typ, ok := m.TypeForOID(pgtype.UUIDOID)
+    require.True(t, ok)
+
+    v, err := typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:])
+    require.NoError(t, err)
+    assert.Equal(t, u, v)
+
+    v, err = typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.TextFormatCode, nil)
+    require.NoError(t, err)
+    assert.Nil(t, v)
+}
+
+func TestOtherTypesUseDefaultCodec(t *testing.T) {
+    m := newMap()
+    u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    var pg pgtype.UUID
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:], &pg))
+    assert.Equal(t, [16]byte(u), pg.Bytes)
+
+    var s string
+    require.NoError(t, m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, u[:], &s))
+    assert.Equal(t, u.String(), s)
+}
+
+func TestTypeForValue(t *testing.T) {
+    m := newMap()
+    typ, ok := m.TypeForValue(uuid.UUID{})
+    require.True(t, ok)
+    assert.Equal(t, uint32(pgtype.UUIDOID), typ.OID)
+}
+
+func TestRegistersIntegration(t *testing.T) {
+    assert.Contains(t, uuid.Introspect().Integrations, "pgx")
+}
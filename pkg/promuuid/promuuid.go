@@ -0,0 +1,102 @@
+// Package promuuid exports UUID generation latencies as Prometheus
+// histograms:
+//
+//    h := promuuid.NewHistograms(promuuid.Opts{})
+//    prometheus.MustRegister(h)
+//    gen, err := uuid.NewGenerator(uuid.VersionUnixTime,
+//        uuid.WithLatencyObserver(h.Observer("orders")))
+package promuuid
+
+import (
+    "strconv"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+    uuid.RegisterIntegration("prometheus")
+}
+
+// DefaultBuckets span 250ns to about 4ms, the range ID issuance SLOs are
+// usually stated in
+var DefaultBuckets = prometheus.ExponentialBuckets(250e-9, 2, 15)
+
+// Opts configures the histograms
+type Opts struct {
+    // Namespace and Subsystem prefix the metric names, as in
+    // prometheus.HistogramOpts
+    Namespace string
+    Subsystem string
+    // Buckets are the histogram bucket upper bounds in seconds; nil uses
+    // DefaultBuckets
+    Buckets []float64
+}
+
+// Histograms is a prometheus.Collector with two histograms labelled by
+// UUID version and generator name: uuid_entropy_read_seconds for reads
+// from the randomness source and uuid_generate_seconds for complete
+// Generate calls
+type Histograms struct {
+    entropy  *prometheus.HistogramVec
+    generate *prometheus.HistogramVec
+}
+
+var _ prometheus.Collector = (*Histograms)(nil)
+
+// NewHistograms creates unregistered histograms
+func NewHistograms(opts Opts) *Histograms {
+    buckets := opts.Buckets
+    if buckets == nil {
+        buckets = DefaultBuckets
+    }
+    labels := []string{"version", "generator"}
+    return &Histograms{
+        entropy: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+            Namespace: opts.Namespace,
+            Subsystem: opts.Subsystem,
+            Name:      "uuid_entropy_read_seconds",
+            Help:      "Latency of reads from the UUID randomness source.",
+            Buckets:   buckets,
+        }, labels),
+        generate: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+            Namespace: opts.Namespace,
+            Subsystem: opts.Subsystem,
+            Name:      "uuid_generate_seconds",
+            Help:      "Latency of complete UUID generation calls.",
+            Buckets:   buckets,
+        }, labels),
+    }
+}
+
+// Describe implements prometheus.Collector
+func (h *Histograms) Describe(ch chan<- *prometheus.Desc) {
+    h.entropy.Describe(ch)
+    h.generate.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (h *Histograms) Collect(ch chan<- prometheus.Metric) {
+    h.entropy.Collect(ch)
+    h.generate.Collect(ch)
+}
+
+// Observer returns a uuid.LatencyObserver recording into the histograms
+// under the given generator name
+func (h *Histograms) Observer(name string) uuid.LatencyObserver {
+    return observer{h: h, name: name}
+}
+
+type observer struct {
+    h    *Histograms
+    name string
+}
+
+func (o observer) ObserveLatency(stage uuid.LatencyStage, version uuid.Version, d time.Duration) {
+    vec := o.h.generate
+    if stage == uuid.StageEntropy {
+        vec = o.h.entropy
+    }
+    vec.WithLabelValues(strconv.Itoa(int(version)), o.name).Observe(d.Seconds())
+}
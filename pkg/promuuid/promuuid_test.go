@@ -0,0 +1,56 @@
+package promuuid
+
+import (
+    "strings"
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/prometheus/client_golang/prometheus"
+    "github.com/prometheus/client_golang/prometheus/testutil"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestHistograms(t *testing.T) {
+    h := NewHistograms(Opts{Namespace: "ids"})
+    reg := prometheus.NewPedanticRegistry()
+    require.NoError(t, reg.Register(h))
+
+    orders := uuid.MustNewGenerator(uuid.VersionUnixTime, uuid.WithLatencyObserver(h.Observer("orders")))
+    users := uuid.MustNewGenerator(uuid.VersionRandom, uuid.WithLatencyObserver(h.Observer("users")))
+    for i := 0; i < 5; i++ {
+        uuid.Must(orders.Generate())
+    }
+    uuid.Must(users.Generate())
+
+    assert.Equal(t, 2, testutil.CollectAndCount(h, "ids_uuid_generate_seconds"))
+    assert.Equal(t, 2, testutil.CollectAndCount(h, "ids_uuid_entropy_read_seconds"))
+
+    families, err := reg.Gather()
+    require.NoError(t, err)
+    counts := map[string]uint64{}
+    for _, mf := range families {
+        for _, m := range mf.GetMetric() {
+            var labels []string
+            for _, l := range m.GetLabel() {
+                labels = append(labels, l.GetName()+"="+l.GetValue())
+            }
+            counts[mf.GetName()+"{"+strings.Join(labels, ",")+"}"] = m.GetHistogram().GetSampleCount()
+        }
+    }
+    assert.Equal(t, map[string]uint64{
+        "ids_uuid_entropy_read_seconds{generator=orders,version=7}": 5,
+        "ids_uuid_entropy_read_seconds{generator=users,version=4}":  1,
+        "ids_uuid_generate_seconds{generator=orders,version=7}":     5,
+        "ids_uuid_generate_seconds{generator=users,version=4}":      1,
+    }, counts)
+}
+
+func TestHistogramBuckets(t *testing.T) {
+    assert.Len(t, DefaultBuckets, 15)
+    assert.InDelta(t, 250e-9, DefaultBuckets[0], 1e-12)
+
+    h := NewHistograms(Opts{Buckets: []float64{1e-6, 1e-3}})
+    h.Observer("x").ObserveLatency(uuid.StageGenerate, uuid.VersionRandom, 0)
+    assert.Equal(t, 1, testutil.CollectAndCount(h, "uuid_generate_seconds"))
+}
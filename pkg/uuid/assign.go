@@ -0,0 +1,70 @@
+package uuid
+
+import "sync"
+
+// IDAssigner hands out a stable UUID per key for the lifetime of the
+// process. Concurrent callers asking for the same key wait for a single
+// generation, so every caller observes the same UUID.
+type IDAssigner struct {
+    gen Generator
+
+    mu  sync.Mutex
+    ids map[string]*assignment
+}
+
+type assignment struct {
+    done chan struct{}
+    id   UUID
+    err  error
+}
+
+// NewIDAssigner creates an IDAssigner drawing new UUIDs from gen, or from a
+// V4 generator if gen is nil
+func NewIDAssigner(gen Generator) *IDAssigner {
+    if gen == nil {
+        gen = MustNewGenerator(VersionRandom)
+    }
+    return &IDAssigner{gen: gen, ids: make(map[string]*assignment)}
+}
+
+// Assign returns the UUID assigned to key, generating one on first use.
+// A failed generation is not remembered, so a later call can retry.
+func (a *IDAssigner) Assign(key string) (UUID, error) {
+    a.mu.Lock()
+    if as, ok := a.ids[key]; ok {
+        a.mu.Unlock()
+        <-as.done
+        return as.id, as.err
+    }
+    as := &assignment{done: make(chan struct{})}
+    a.ids[key] = as
+    a.mu.Unlock()
+
+    as.id, as.err = a.gen.Generate()
+    if as.err != nil {
+        a.mu.Lock()
+        delete(a.ids, key)
+        a.mu.Unlock()
+    }
+    close(as.done)
+    return as.id, as.err
+}
+
+// Lookup returns the UUID already assigned to key, if any
+func (a *IDAssigner) Lookup(key string) (UUID, bool) {
+    a.mu.Lock()
+    as, ok := a.ids[key]
+    a.mu.Unlock()
+    if !ok {
+        return Nil, false
+    }
+    <-as.done
+    return as.id, as.err == nil
+}
+
+// Len returns the number of keys with an assigned UUID
+func (a *IDAssigner) Len() int {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+    return len(a.ids)
+}
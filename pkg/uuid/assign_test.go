@@ -0,0 +1,50 @@
+package uuid
+
+import (
+    "sync"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestIDAssigner(t *testing.T) {
+    a := NewIDAssigner(nil)
+
+    first, err := a.Assign("node-1")
+    require.NoError(t, err)
+    again, err := a.Assign("node-1")
+    require.NoError(t, err)
+    other, err := a.Assign("node-2")
+    require.NoError(t, err)
+
+    assert.Equal(t, first, again)
+    assert.NotEqual(t, first, other)
+    assert.Equal(t, 2, a.Len())
+
+    got, ok := a.Lookup("node-1")
+    assert.True(t, ok)
+    assert.Equal(t, first, got)
+
+    _, ok = a.Lookup("missing")
+    assert.False(t, ok)
+}
+
+func TestIDAssignerConcurrent(t *testing.T) {
+    a := NewIDAssigner(MustNewGenerator(VersionUnixTime))
+
+    var wg sync.WaitGroup
+    results := make([]UUID, 50)
+    for i := range results {
+        wg.Add(1)
+        go func(i int) {
+            defer wg.Done()
+            results[i] = Must(a.Assign("shared"))
+        }(i)
+    }
+    wg.Wait()
+
+    for _, id := range results {
+        assert.Equal(t, results[0], id)
+    }
+}
@@ -0,0 +1,92 @@
+package uuid
+
+import "fmt"
+
+// base58Alphabet is the Bitcoin base58 alphabet, which omits 0, O, I and l
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Values maps a base58 character to its value, or 0xff
+var base58Values = func() [256]byte {
+    var t [256]byte
+    for i := range t {
+        t[i] = 0xff
+    }
+    for i := 0; i < len(base58Alphabet); i++ {
+        t[base58Alphabet[i]] = byte(i)
+    }
+    return t
+}()
+
+// maxBase58Len is the longest base58 encoding of 16 bytes
+const maxBase58Len = 22
+
+// ToBase58 encodes u in base58 with the Bitcoin alphabet. Leading zero
+// bytes are written as '1', so the encoding is between 16 and 22
+// characters long.
+func (u UUID) ToBase58() string {
+    var dst [maxBase58Len + Size]byte
+    num := u
+    pos := len(dst)
+
+    // Repeatedly divide the big-endian number by 58, emitting remainders
+    start := 0
+    for start < Size && num[start] == 0 {
+        start++
+    }
+    for i := start; i < Size; {
+        var rem int
+        for j := i; j < Size; j++ {
+            acc := rem<<8 | int(num[j])
+            num[j] = byte(acc / 58)
+            rem = acc % 58
+        }
+        pos--
+        dst[pos] = base58Alphabet[rem]
+        for i < Size && num[i] == 0 {
+            i++
+        }
+    }
+    for i := 0; i < start; i++ {
+        pos--
+        dst[pos] = '1'
+    }
+    return string(dst[pos:])
+}
+
+// FromBase58 decodes a UUID encoded by ToBase58
+func FromBase58(s string) (UUID, error) {
+    var uuid UUID
+    if len(s) == 0 || len(s) > maxBase58Len {
+        return Nil, fmt.Errorf("%w: %d base58 characters", ErrInvalidLength, len(s))
+    }
+
+    zeros := 0
+    for zeros < len(s) && s[zeros] == '1' {
+        zeros++
+    }
+    for i := 0; i < len(s); i++ {
+        v := base58Values[s[i]]
+        if v == 0xff {
+            return Nil, &SyntaxError{Offset: i, Msg: fmt.Sprintf("invalid base58 character %q", s[i])}
+        }
+        carry := int(v)
+        for j := Size - 1; j >= 0; j-- {
+            carry += int(uuid[j]) * 58
+            uuid[j] = byte(carry)
+            carry >>= 8
+        }
+        if carry != 0 {
+            return Nil, fmt.Errorf("%w: base58 %q overflows 128 bits", ErrInvalidFormat, s)
+        }
+    }
+
+    // The leading '1's must account for exactly the leading zero bytes
+    lead := 0
+    for lead < Size && uuid[lead] == 0 {
+        lead++
+    }
+    if zeros != lead {
+        return Nil, fmt.Errorf("%w: base58 %q is not canonical", ErrInvalidFormat, s)
+    }
+    return uuid, nil
+}
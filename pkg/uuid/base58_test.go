@@ -0,0 +1,40 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestToBase58(t *testing.T) {
+    assert.Equal(t, "1111111111111111", Nil.ToBase58())
+    assert.Equal(t, "YcVfxkQb6JRzqk5kF2tNLv", maxUUID.ToBase58())
+
+    var one UUID
+    one[15] = 1
+    assert.Equal(t, "1111111111111112", one.ToBase58())
+}
+
+func TestBase58RoundTrip(t *testing.T) {
+    for _, uuid := range []UUID{Nil, maxUUID, NewV5(NamespaceDNS, "example.com"), New(), New()} {
+        s := uuid.ToBase58()
+        back, err := FromBase58(s)
+        require.NoError(t, err, s)
+        assert.Equal(t, uuid, back)
+    }
+}
+
+func TestFromBase58Invalid(t *testing.T) {
+    for _, s := range []string{
+        "",
+        "0111111111111111",
+        "YcVfxkQb6JRzqk5kF2tNLw",
+        "zzzzzzzzzzzzzzzzzzzzzz",
+        "2",
+        "12",
+    } {
+        _, err := FromBase58(s)
+        assert.Error(t, err, s)
+    }
+}
@@ -0,0 +1,42 @@
+package bench
+
+import (
+    "errors"
+    "testing"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+)
+
+func TestGenerator(t *testing.T) {
+    r := Generator("v7", uuid.MustNewGenerator(uuid.VersionUnixTime), Options{Ops: 1000, Samples: 100})
+    assert.Equal(t, "v7", r.Name)
+    assert.Equal(t, 1000, r.Ops)
+    assert.Zero(t, r.Errors)
+    assert.True(t, r.OpsPerSec > 0)
+    assert.True(t, r.P50 <= r.P90 && r.P90 <= r.P99 && r.P99 <= r.Max)
+}
+
+func TestFuncErrors(t *testing.T) {
+    r := Func("fail", func() error { return errors.New("boom") }, Options{Ops: 10, Samples: 5})
+    assert.Equal(t, 15, r.Errors)
+}
+
+func TestSuite(t *testing.T) {
+    results := Suite(Options{Ops: 100, Samples: 10})
+    assert.Len(t, results, 8)
+    for _, r := range results {
+        assert.Zero(t, r.Errors, r.Name)
+    }
+}
+
+func TestPercentile(t *testing.T) {
+    samples := make([]time.Duration, 100)
+    for i := range samples {
+        samples[i] = time.Duration(i + 1)
+    }
+    assert.Equal(t, time.Duration(50), percentile(samples, 50))
+    assert.Equal(t, time.Duration(99), percentile(samples, 99))
+    assert.Equal(t, time.Duration(1), percentile(samples[:1], 99))
+}
@@ -0,0 +1,49 @@
+package uuid
+
+import "database/sql/driver"
+
+// BinaryUUID is a UUID stored in the database as 16 raw bytes, for
+// Postgres bytea or MySQL BINARY(16) columns, instead of the 36-character
+// text form used by UUID. Its text and JSON forms are those of UUID.
+type BinaryUUID UUID
+
+// UUID returns the UUID
+func (b BinaryUUID) UUID() UUID {
+    return UUID(b)
+}
+
+// String returns the canonical string form
+func (b BinaryUUID) String() string {
+    return UUID(b).String()
+}
+
+// Value implements driver.Valuer, returning the 16 raw bytes
+func (b BinaryUUID) Value() (driver.Value, error) {
+    return UUID(b).ValueAs(ValueBytes)
+}
+
+// Scan implements sql.Scanner. It accepts 16-byte binary values as well as
+// every form UUID.Scan accepts, so columns can be migrated in place.
+func (b *BinaryUUID) Scan(value interface{}) error {
+    return (*UUID)(b).Scan(value)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (b BinaryUUID) MarshalText() ([]byte, error) {
+    return UUID(b).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (b *BinaryUUID) UnmarshalText(text []byte) error {
+    return (*UUID)(b).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler
+func (b BinaryUUID) MarshalJSON() ([]byte, error) {
+    return UUID(b).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *BinaryUUID) UnmarshalJSON(data []byte) error {
+    return (*UUID)(b).UnmarshalJSON(data)
+}
@@ -0,0 +1,41 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestBinaryUUIDValue(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    v, err := BinaryUUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+
+    var b BinaryUUID
+    require.NoError(t, b.Scan(v))
+    assert.Equal(t, u, b.UUID())
+
+    require.NoError(t, b.Scan(u.String()))
+    assert.Equal(t, u, b.UUID())
+    assert.Error(t, b.Scan(42))
+}
+
+func TestBinaryUUIDJSON(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    data, err := json.Marshal(BinaryUUID(u))
+    require.NoError(t, err)
+    assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, string(data))
+
+    var b BinaryUUID
+    require.NoError(t, json.Unmarshal(data, &b))
+    assert.Equal(t, u.String(), b.String())
+
+    text, err := b.MarshalText()
+    require.NoError(t, err)
+    var back BinaryUUID
+    require.NoError(t, back.UnmarshalText(text))
+    assert.Equal(t, b, back)
+}
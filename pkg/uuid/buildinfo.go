@@ -0,0 +1,70 @@
+package uuid
+
+import (
+    "sort"
+    "sync"
+)
+
+// BuildInfo describes what this package offers in the running binary, for
+// diagnostics endpoints and plugin hosts. Unlike Capabilities it is not
+// meant to be sent to peers.
+type BuildInfo struct {
+    // Versions are the UUID versions that can be generated
+    Versions []Version `json:"versions"`
+    // Encodings names the supported text and binary encodings
+    Encodings []string `json:"encodings"`
+    // Features names optional features that depend on the build target,
+    // such as "hardware-node" and "file-coordinator"
+    Features []string `json:"features"`
+    // Integrations names the integration modules linked into the binary,
+    // see RegisterIntegration
+    Integrations []string `json:"integrations"`
+}
+
+// supportedEncodings are the encodings this package can read and write
+var supportedEncodings = []string{
+    "canonical", "hex", "braced", "urn", "dotnet", "base58", "ulid", "xid",
+    "typeid", "prefixed", "binary", "guid-bytes", "mysql-ordered",
+}
+
+// integrations holds the names passed to RegisterIntegration
+var integrations struct {
+    mu    sync.Mutex
+    names []string
+}
+
+// RegisterIntegration records that the named integration module is linked
+// into the binary, so it is listed by Introspect. Integration modules call
+// it from an init function; registering a name twice has no effect.
+func RegisterIntegration(name string) {
+    integrations.mu.Lock()
+    defer integrations.mu.Unlock()
+    for _, n := range integrations.names {
+        if n == name {
+            return
+        }
+    }
+    integrations.names = append(integrations.names, name)
+    sort.Strings(integrations.names)
+}
+
+// Introspect returns the build information of this package as compiled
+// into the running binary, including features that depend on build tags
+// and registered integrations
+func Introspect() BuildInfo {
+    info := BuildInfo{
+        Versions:  append([]Version(nil), generatedVersions...),
+        Encodings: append([]string(nil), supportedEncodings...),
+    }
+    if hardwareNodeSupported {
+        info.Features = append(info.Features, "hardware-node")
+    }
+    if fileLockSupported {
+        info.Features = append(info.Features, "file-coordinator")
+    }
+
+    integrations.mu.Lock()
+    info.Integrations = append([]string(nil), integrations.names...)
+    integrations.mu.Unlock()
+    return info
+}
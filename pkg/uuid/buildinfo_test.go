@@ -0,0 +1,28 @@
+package uuid
+
+import (
+    "slices"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestIntrospect(t *testing.T) {
+    info := Introspect()
+    assert.Equal(t, LocalCapabilities().Versions, info.Versions)
+    assert.Contains(t, info.Encodings, "canonical")
+    assert.Contains(t, info.Encodings, "base58")
+    assert.Equal(t, hardwareNodeSupported, slices.Contains(info.Features, "hardware-node"))
+    assert.Equal(t, fileLockSupported, slices.Contains(info.Features, "file-coordinator"))
+
+    RegisterIntegration("test-b")
+    RegisterIntegration("test-a")
+    RegisterIntegration("test-b")
+    info = Introspect()
+    assert.Subset(t, info.Integrations, []string{"test-a", "test-b"})
+    assert.True(t, slices.IsSorted(info.Integrations))
+    assert.Equal(t, slices.Compact(slices.Clone(info.Integrations)), info.Integrations)
+
+    // Build details stay out of the negotiation header
+    assert.Equal(t, "versions=1,3,4,5,6,7,8", LocalCapabilities().String())
+}
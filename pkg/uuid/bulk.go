@@ -0,0 +1,65 @@
+package uuid
+
+import (
+    "strconv"
+    "strings"
+)
+
+// Dialect selects the bind parameter syntax of a SQL database
+type Dialect int
+
+const (
+    // DialectPostgres uses numbered parameters: $1, $2, ...
+    DialectPostgres Dialect = iota
+    // DialectMySQL uses positional parameters: ?, ?, ...
+    DialectMySQL
+)
+
+// ValuerSlice converts ids into query arguments, e.g. for an IN clause or a
+// multi-row INSERT built with Placeholders or RowPlaceholders
+func ValuerSlice(ids []UUID) []interface{} {
+    args := make([]interface{}, len(ids))
+    for i, id := range ids {
+        args[i] = id
+    }
+    return args
+}
+
+// Placeholders returns n comma-separated bind parameters. For Postgres the
+// first parameter is numbered offset+1, so several lists can share a query.
+//
+//    "WHERE id IN (" + Placeholders(DialectPostgres, len(ids), 0) + ")"
+func Placeholders(d Dialect, n, offset int) string {
+    var sb strings.Builder
+    writePlaceholders(&sb, d, n, offset)
+    return sb.String()
+}
+
+// RowPlaceholders returns rows parenthesized groups of cols bind parameters
+// for a multi-row INSERT, e.g. "($1, $2), ($3, $4)"
+func RowPlaceholders(d Dialect, rows, cols int) string {
+    var sb strings.Builder
+    for r := 0; r < rows; r++ {
+        if r > 0 {
+            sb.WriteString(", ")
+        }
+        sb.WriteByte('(')
+        writePlaceholders(&sb, d, cols, r*cols)
+        sb.WriteByte(')')
+    }
+    return sb.String()
+}
+
+func writePlaceholders(sb *strings.Builder, d Dialect, n, offset int) {
+    for i := 0; i < n; i++ {
+        if i > 0 {
+            sb.WriteString(", ")
+        }
+        if d == DialectPostgres {
+            sb.WriteByte('$')
+            sb.WriteString(strconv.Itoa(offset + i + 1))
+        } else {
+            sb.WriteByte('?')
+        }
+    }
+}
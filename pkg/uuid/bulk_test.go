@@ -0,0 +1,30 @@
+package uuid
+
+import (
+    "database/sql/driver"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestValuerSlice(t *testing.T) {
+    ids := []UUID{New(), New()}
+    args := ValuerSlice(ids)
+    assert.Len(t, args, 2)
+
+    v, err := args[1].(driver.Valuer).Value()
+    assert.NoError(t, err)
+    assert.Equal(t, ids[1].String(), v)
+}
+
+func TestPlaceholders(t *testing.T) {
+    assert.Equal(t, "$1, $2, $3", Placeholders(DialectPostgres, 3, 0))
+    assert.Equal(t, "$3, $4", Placeholders(DialectPostgres, 2, 2))
+    assert.Equal(t, "?, ?, ?", Placeholders(DialectMySQL, 3, 0))
+    assert.Equal(t, "", Placeholders(DialectMySQL, 0, 0))
+}
+
+func TestRowPlaceholders(t *testing.T) {
+    assert.Equal(t, "($1, $2), ($3, $4)", RowPlaceholders(DialectPostgres, 2, 2))
+    assert.Equal(t, "(?, ?, ?), (?, ?, ?)", RowPlaceholders(DialectMySQL, 2, 3))
+}
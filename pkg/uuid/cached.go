@@ -0,0 +1,83 @@
+package uuid
+
+import "database/sql/driver"
+
+// CachedUUID is an immutable UUID that carries its formatted string, for
+// read-mostly values such as tenant or organization IDs that are
+// formatted over and over. The string is built once by NewCachedUUID or
+// when decoding; copies share it, and changing the UUID means building a
+// new CachedUUID. The zero value is the nil UUID and formats without the
+// cache.
+type CachedUUID struct {
+    uuid UUID
+    str  string
+}
+
+// NewCachedUUID returns u with its string form precomputed
+func NewCachedUUID(u UUID) CachedUUID {
+    return CachedUUID{uuid: u, str: u.String()}
+}
+
+// UUID returns the UUID
+func (c CachedUUID) UUID() UUID {
+    return c.uuid
+}
+
+// String returns the cached canonical string form
+func (c CachedUUID) String() string {
+    if c.str == "" {
+        return c.uuid.String()
+    }
+    return c.str
+}
+
+// MarshalText implements encoding.TextMarshaler, writing the same form as
+// UUID.MarshalText
+func (c CachedUUID) MarshalText() ([]byte, error) {
+    return c.uuid.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *CachedUUID) UnmarshalText(text []byte) error {
+    u, err := Parse(string(text))
+    if err != nil {
+        return err
+    }
+    *c = NewCachedUUID(u)
+    return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing the same form as
+// UUID.MarshalJSON
+func (c CachedUUID) MarshalJSON() ([]byte, error) {
+    return c.uuid.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (c *CachedUUID) UnmarshalJSON(data []byte) error {
+    var u UUID
+    if err := u.UnmarshalJSON(data); err != nil {
+        return err
+    }
+    *c = NewCachedUUID(u)
+    return nil
+}
+
+// Value implements driver.Valuer, using the cached string when the value
+// format is ValueString
+func (c CachedUUID) Value() (driver.Value, error) {
+    if ValueFormat(valueFormat.Load()) == ValueString {
+        return c.String(), nil
+    }
+    return c.uuid.Value()
+}
+
+// Scan implements sql.Scanner
+func (c *CachedUUID) Scan(value interface{}) error {
+    var u UUID
+    if err := u.Scan(value); err != nil {
+        return err
+    }
+    *c = NewCachedUUID(u)
+    return nil
+}
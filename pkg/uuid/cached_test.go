@@ -0,0 +1,56 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestCachedUUID(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    c := NewCachedUUID(u)
+    assert.Equal(t, u, c.UUID())
+    assert.Equal(t, u.String(), c.String())
+    assert.Equal(t, Nil.String(), CachedUUID{}.String())
+
+    allocs := testing.AllocsPerRun(100, func() {
+        _ = c.String()
+    })
+    assert.Zero(t, allocs)
+}
+
+func TestCachedUUIDEncoding(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    c := NewCachedUUID(u)
+
+    data, err := json.Marshal(c)
+    require.NoError(t, err)
+    assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, string(data))
+
+    var back CachedUUID
+    require.NoError(t, json.Unmarshal(data, &back))
+    assert.Equal(t, c, back)
+    assert.Error(t, json.Unmarshal([]byte(`"nope"`), &back))
+
+    require.NoError(t, back.UnmarshalText([]byte(u.String())))
+    assert.Equal(t, c, back)
+
+    v, err := c.Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), v)
+
+    var scanned CachedUUID
+    require.NoError(t, scanned.Scan(u[:]))
+    assert.Equal(t, c, scanned)
+    assert.Error(t, scanned.Scan(1))
+}
+
+func BenchmarkCachedUUIDString(b *testing.B) {
+    c := NewCachedUUID(New())
+    b.ReportAllocs()
+    for i := 0; i < b.N; i++ {
+        _ = c.String()
+    }
+}
@@ -0,0 +1,46 @@
+package uuid
+
+// wellKnown maps recognizable UUIDs to a human-readable label. It is built
+// once at init and never modified, so lookups need no locking.
+var wellKnown = map[UUID]string{
+    Nil: "Nil UUID",
+    MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"): "Max UUID",
+
+    // RFC 9562 namespaces
+    NamespaceDNS:  "Namespace DNS",
+    NamespaceURL:  "Namespace URL",
+    NamespaceOID:  "Namespace OID",
+    NamespaceX500: "Namespace X.500",
+
+    // Bluetooth
+    MustParse("00000000-0000-1000-8000-00805f9b34fb"): "Bluetooth Base UUID",
+
+    // GPT partition types
+    MustParse("c12a7328-f81f-11d2-ba4b-00a0c93ec93b"): "GPT EFI System Partition",
+    MustParse("21686148-6449-6e6f-744e-656564454649"): "GPT BIOS Boot Partition",
+    MustParse("e3c9e316-0b5c-4db8-817d-f92df00215ae"): "GPT Microsoft Reserved Partition",
+    MustParse("ebd0a0a2-b9e5-4433-87c0-68b6b72699c7"): "GPT Microsoft Basic Data Partition",
+    MustParse("de94bba4-06d1-4d40-a16a-bfd50179d6ac"): "GPT Windows Recovery Environment",
+    MustParse("0fc63daf-8483-4772-8e79-3d69d8477de4"): "GPT Linux Filesystem Data",
+    MustParse("4f68bce3-e8cd-4db1-96e7-fbcaf984b709"): "GPT Linux Root (x86-64)",
+    MustParse("0657fd6d-a4ab-43c4-84e5-0933c84b4f4f"): "GPT Linux Swap",
+    MustParse("e6d6d379-f507-44c2-a23c-238f2a3df928"): "GPT Linux LVM",
+    MustParse("a19d880f-05fc-4d3b-a006-743f0f84911e"): "GPT Linux RAID",
+    MustParse("48465300-0000-11aa-aa11-00306543ecac"): "GPT Apple HFS+",
+    MustParse("7c3457ef-0000-11aa-aa11-00306543ecac"): "GPT Apple APFS",
+
+    // Microsoft COM interfaces and shell folders
+    MustParse("00000000-0000-0000-c000-000000000046"): "COM IUnknown",
+    MustParse("00000001-0000-0000-c000-000000000046"): "COM IClassFactory",
+    MustParse("00020400-0000-0000-c000-000000000046"): "COM IDispatch",
+    MustParse("20d04fe0-3aea-1069-a2d8-08002b30309d"): "Shell This PC",
+    MustParse("645ff040-5081-101b-9f08-00aa002f954e"): "Shell Recycle Bin",
+    MustParse("21ec2020-3aea-1069-a2dd-08002b30309d"): "Shell Control Panel",
+}
+
+// Lookup returns the label of a well-known UUID such as an RFC namespace,
+// the Bluetooth base UUID, a GPT partition type or a Microsoft CLSID/IID
+func Lookup(u UUID) (string, bool) {
+    name, ok := wellKnown[u]
+    return name, ok
+}
@@ -0,0 +1,20 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+    name, ok := Lookup(NamespaceDNS)
+    assert.True(t, ok)
+    assert.Equal(t, "Namespace DNS", name)
+
+    name, ok = Lookup(MustParse("C12A7328-F81F-11D2-BA4B-00A0C93EC93B"))
+    assert.True(t, ok)
+    assert.Equal(t, "GPT EFI System Partition", name)
+
+    _, ok = Lookup(New())
+    assert.False(t, ok)
+}
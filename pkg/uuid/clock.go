@@ -0,0 +1,33 @@
+package uuid
+
+import "time"
+
+// Clock supplies the current time to time-based generators
+type Clock interface {
+    Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns f()
+func (f ClockFunc) Now() time.Time {
+    return f()
+}
+
+// WithClock makes a generator read the time from c instead of time.Now,
+// which allows deterministic V1, V6 and V7 output in tests and simulations
+// running on virtual time
+func WithClock(c Clock) Option {
+    return func(g *UUIDGenerator) {
+        g.clock = c
+    }
+}
+
+// now returns the current time from the generator's clock
+func (g *UUIDGenerator) now() time.Time {
+    if g.clock != nil {
+        return g.clock.Now()
+    }
+    return time.Now()
+}
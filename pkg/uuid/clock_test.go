@@ -0,0 +1,40 @@
+package uuid
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestWithClock(t *testing.T) {
+    fixed := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+    clock := ClockFunc(func() time.Time { return fixed })
+
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime, VersionUnixTime} {
+        gen := MustNewGenerator(version, WithClock(clock))
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+
+        ts, err := uuid.Time()
+        require.NoError(t, err)
+        assert.True(t, fixed.Equal(ts), "version %d: got %v", version, ts)
+    }
+}
+
+func TestWithClockDeterministic(t *testing.T) {
+    fixed := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+    newGen := func() *UUIDGenerator {
+        gen := MustNewGenerator(VersionReorderedTime, WithClock(ClockFunc(func() time.Time { return fixed })))
+        require.NoError(t, gen.SetNodeID([]byte{1, 2, 3, 4, 5, 6}))
+        require.NoError(t, gen.SetClockSequence(42))
+        return gen
+    }
+
+    a, err := newGen().Generate()
+    require.NoError(t, err)
+    b, err := newGen().Generate()
+    require.NoError(t, err)
+    assert.Equal(t, a, b)
+}
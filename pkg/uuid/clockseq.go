@@ -0,0 +1,55 @@
+package uuid
+
+// ClockSequence returns the 14-bit clock sequence of a time-based (V1, V2
+// or V6) UUID, or -1 for other versions
+func (u UUID) ClockSequence() int {
+    switch u.Version() {
+    case VersionTimeBased, VersionDCESecurity, VersionReorderedTime:
+        return int(u[8]&0x3f)<<8 | int(u[9])
+    default:
+        return -1
+    }
+}
+
+// ClockSequence returns the clock sequence the generator will use for its
+// next time-based UUID
+func (g *UUIDGenerator) ClockSequence() (int, error) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if !g.seqSet {
+        if err := g.reseed(); err != nil {
+            return 0, err
+        }
+    }
+    return int(g.clockSeq), nil
+}
+
+// SetClockSequence sets the generator's clock sequence to the low 14 bits
+// of seq. A negative seq picks a new random clock sequence, which is how
+// processes sharing a node ID avoid colliding with each other.
+func (g *UUIDGenerator) SetClockSequence(seq int) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if seq < 0 {
+        return g.reseed()
+    }
+    g.clockSeq = uint16(seq) & 0x3fff
+    g.seqSet = true
+    return nil
+}
+
+// SetClockSequence sets the clock sequence used by NewV1 and NewV6
+func SetClockSequence(seq int) error {
+    return defaultTimeGenerator.SetClockSequence(seq)
+}
+
+// reseed picks a random clock sequence. Callers must hold g.mu.
+func (g *UUIDGenerator) reseed() error {
+    var b [2]byte
+    if err := g.readRandom(b[:]); err != nil {
+        return err
+    }
+    g.clockSeq = (uint16(b[0])<<8 | uint16(b[1])) & 0x3fff
+    g.seqSet = true
+    return nil
+}
@@ -0,0 +1,44 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestSetClockSequence(t *testing.T) {
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime} {
+        gen := MustNewGenerator(version)
+        require.NoError(t, gen.SetClockSequence(0x1234))
+
+        seq, err := gen.ClockSequence()
+        require.NoError(t, err)
+        assert.Equal(t, 0x1234, seq)
+
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, 0x1234, uuid.ClockSequence())
+        assert.Equal(t, VariantRFC4122, uuid.Variant())
+    }
+}
+
+func TestSetClockSequenceMasks(t *testing.T) {
+    gen := MustNewGenerator(VersionTimeBased)
+    require.NoError(t, gen.SetClockSequence(0xffff))
+    seq, err := gen.ClockSequence()
+    require.NoError(t, err)
+    assert.Equal(t, 0x3fff, seq)
+}
+
+func TestSetClockSequenceRandom(t *testing.T) {
+    gen := MustNewGenerator(VersionTimeBased)
+    require.NoError(t, gen.SetClockSequence(-1))
+    seq, err := gen.ClockSequence()
+    require.NoError(t, err)
+    assert.True(t, seq >= 0 && seq <= 0x3fff)
+}
+
+func TestUUIDClockSequenceNonTimeBased(t *testing.T) {
+    assert.Equal(t, -1, New().ClockSequence())
+}
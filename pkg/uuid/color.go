@@ -0,0 +1,72 @@
+package uuid
+
+import (
+    "crypto/sha256"
+    "fmt"
+    "math"
+)
+
+// HSL derives a stable display color from u, for color-coding entities in
+// user interfaces. The algorithm is fixed so other languages can reproduce
+// it exactly:
+//
+//  1. h = SHA-256 of the 16 bytes of u
+//  2. hue        = (h[0]<<8 | h[1]) * 360 / 65536, integer division (0-359)
+//  3. saturation = 65 + h[2] % 20, in percent (65-84)
+//  4. lightness  = 45 + h[3] % 15, in percent (45-59)
+//
+// Hashing first spreads the hue evenly even for time-ordered UUIDs whose
+// leading bytes barely change.
+func (u UUID) HSL() (hue, saturation, lightness int) {
+    h := sha256.Sum256(u[:])
+    hue = (int(h[0])<<8 | int(h[1])) * 360 / 65536
+    saturation = 65 + int(h[2])%20
+    lightness = 45 + int(h[3])%15
+    return hue, saturation, lightness
+}
+
+// ColorHex returns the color of HSL as a CSS "#rrggbb" string. The
+// conversion is the standard HSL to RGB formula in float64, with each
+// channel scaled to 0-255 and rounded half up:
+//
+//	c = (1 - |2l - 1|) * s
+//	x = c * (1 - |(hue/60) mod 2 - 1|)
+//	m = l - c/2
+//	(r, g, b) = (c, x, 0), (x, c, 0), (0, c, x), (0, x, c), (x, 0, c) or
+//	            (c, 0, x) for hue in [0,60), [60,120), ... [300,360)
+//	channel = floor((v + m) * 255 + 0.5)
+func (u UUID) ColorHex() string {
+    hue, sat, light := u.HSL()
+    r, g, b := hslToRGB(hue, sat, light)
+    return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// hslToRGB converts a hue in degrees and saturation and lightness in
+// percent to 8-bit RGB channels
+func hslToRGB(hue, sat, light int) (r, g, b uint8) {
+    s := float64(sat) / 100
+    l := float64(light) / 100
+    c := (1 - math.Abs(2*l-1)) * s
+    x := c * (1 - math.Abs(math.Mod(float64(hue)/60, 2)-1))
+    m := l - c/2
+
+    var rf, gf, bf float64
+    switch hue / 60 {
+    case 0:
+        rf, gf, bf = c, x, 0
+    case 1:
+        rf, gf, bf = x, c, 0
+    case 2:
+        rf, gf, bf = 0, c, x
+    case 3:
+        rf, gf, bf = 0, x, c
+    case 4:
+        rf, gf, bf = x, 0, c
+    default:
+        rf, gf, bf = c, 0, x
+    }
+    channel := func(v float64) uint8 {
+        return uint8(math.Floor((v+m)*255 + 0.5))
+    }
+    return channel(rf), channel(gf), channel(bf)
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestHSL(t *testing.T) {
+    for i := 0; i < 1000; i++ {
+        h, s, l := New().HSL()
+        assert.True(t, h >= 0 && h < 360)
+        assert.True(t, s >= 65 && s <= 84)
+        assert.True(t, l >= 45 && l <= 59)
+    }
+
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    h1, s1, l1 := uuid.HSL()
+    h2, s2, l2 := uuid.HSL()
+    assert.Equal(t, []int{h1, s1, l1}, []int{h2, s2, l2})
+}
+
+func TestColorHexVectors(t *testing.T) {
+    // Reference values for implementations in other languages
+    for _, tc := range []struct {
+        uuid    string
+        h, s, l int
+        hex     string
+    }{
+        {"00000000-0000-0000-0000-000000000000", 77, 73, 45, "#97c71f"},
+        {"550e8400-e29b-41d4-a716-446655440000", 290, 80, 52, "#c623e7"},
+        {"6ba7b810-9dad-11d1-80b4-00c04fd430c8", 110, 84, 54, "#48ec27"},
+    } {
+        uuid := MustParse(tc.uuid)
+        h, s, l := uuid.HSL()
+        assert.Equal(t, []int{tc.h, tc.s, tc.l}, []int{h, s, l}, tc.uuid)
+        assert.Equal(t, tc.hex, uuid.ColorHex(), tc.uuid)
+    }
+}
+
+func TestHSLToRGB(t *testing.T) {
+    for _, tc := range []struct {
+        h, s, l int
+        r, g, b uint8
+    }{
+        {0, 100, 50, 255, 0, 0},
+        {120, 100, 50, 0, 255, 0},
+        {240, 100, 50, 0, 0, 255},
+        {60, 100, 50, 255, 255, 0},
+        {300, 100, 50, 255, 0, 255},
+        {0, 0, 50, 128, 128, 128},
+        {210, 65, 45, 40, 115, 189},
+    } {
+        r, g, b := hslToRGB(tc.h, tc.s, tc.l)
+        assert.Equal(t, []uint8{tc.r, tc.g, tc.b}, []uint8{r, g, b}, "%v", tc)
+    }
+}
+
+func TestHueDistribution(t *testing.T) {
+    var buckets [12]int
+    for i := 0; i < 12000; i++ {
+        h, _, _ := New().HSL()
+        buckets[h/30]++
+    }
+    for i, n := range buckets {
+        assert.InDelta(t, 1000, n, 200, "bucket %d", i)
+    }
+}
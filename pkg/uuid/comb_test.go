@@ -0,0 +1,92 @@
+package uuid
+
+import (
+    "bytes"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestNewCOMB(t *testing.T) {
+    before := time.Now().Truncate(time.Millisecond)
+    u, err := NewCOMB()
+    require.NoError(t, err)
+    assert.Equal(t, VersionRandom, u.Version())
+    assert.Equal(t, VariantRFC4122, u.Variant())
+
+    ts, err := u.COMBTime()
+    require.NoError(t, err)
+    assert.False(t, ts.Before(before))
+    assert.False(t, ts.After(time.Now()))
+
+    _, err = NamespaceDNS.COMBTime()
+    assert.Error(t, err)
+}
+
+func TestCOMBGeneratorOrder(t *testing.T) {
+    start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    gen := NewCOMBGenerator(WithClock(&virtualClock{now: start, step: time.Millisecond}))
+    assert.Equal(t, VersionRandom, gen.Version())
+
+    var prev UUID
+    for i := 0; i < 100; i++ {
+        u := Must(gen.Generate())
+        ts, err := u.COMBTime()
+        require.NoError(t, err)
+        assert.Equal(t, start.Add(time.Duration(i)*time.Millisecond), ts.UTC())
+
+        // SQL Server compares the last six bytes first
+        if i > 0 {
+            assert.Equal(t, 1, bytes.Compare(u[10:], prev[10:]))
+        }
+        prev = u
+    }
+}
+
+func TestCOMBGeneratorClockRegression(t *testing.T) {
+    times := []time.Time{time.UnixMilli(2000), time.UnixMilli(1000)}
+    i := 0
+    gen := NewCOMBGenerator(WithClock(ClockFunc(func() time.Time {
+        t := times[i]
+        i++
+        return t
+    })))
+
+    a := Must(gen.Generate())
+    b := Must(gen.Generate())
+    ta, _ := a.COMBTime()
+    tb, _ := b.COMBTime()
+    assert.Equal(t, ta, tb)
+
+    _, err := NewCOMBGenerator(WithRand(failingReader{})).Generate()
+    assert.Error(t, err)
+}
+
+func TestCOMBLeadingLayout(t *testing.T) {
+    start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    gen := NewCOMBGeneratorLayout(COMBLeading, WithClock(&virtualClock{now: start, step: time.Millisecond}))
+    assert.Equal(t, COMBLeading, gen.Layout())
+    assert.Equal(t, COMBSQLServer, NewCOMBGenerator().Layout())
+
+    var prev UUID
+    for i := 0; i < 100; i++ {
+        u := Must(gen.Generate())
+        assert.Equal(t, VersionRandom, u.Version())
+        assert.Equal(t, VariantRFC4122, u.Variant())
+
+        ts, err := COMBLeading.Time(u)
+        require.NoError(t, err)
+        assert.Equal(t, start.Add(time.Duration(i)*time.Millisecond), ts.UTC())
+
+        // Plain byte order follows the timestamp
+        if i > 0 {
+            assert.Equal(t, 1, u.Compare(prev))
+        }
+        prev = u
+    }
+
+    _, err := COMBLeading.Time(NamespaceDNS)
+    assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package uuid
+
+import (
+    "bufio"
+    "encoding/hex"
+    "fmt"
+    "io"
+    "strings"
+    "sync"
+    "time"
+)
+
+// GeneratorConfig describes a generator declaratively so it can be loaded
+// from a service's JSON or YAML configuration. The zero value of every
+// field other than Version selects the default behavior.
+type GeneratorConfig struct {
+    // Version is the UUID version to generate
+    Version int `json:"version" yaml:"version"`
+    // Monotonic requires every UUID to sort strictly after the previous
+    // one. Only version 7 generators make that guarantee.
+    Monotonic bool `json:"monotonic,omitempty" yaml:"monotonic,omitempty"`
+    // SubMillisecond enables WithSubMillisecondPrecision for version 7
+    SubMillisecond bool `json:"subMillisecond,omitempty" yaml:"subMillisecond,omitempty"`
+    // Node selects the node ID of version 1 and 6 generators: empty for the
+    // host's hardware address, "random" for a random node ID, or 12 hex
+    // digits optionally separated by colons or hyphens
+    Node string `json:"node,omitempty" yaml:"node,omitempty"`
+    // RandPoolSize, if positive, buffers that many bytes read from the
+    // randomness source at a time
+    RandPoolSize int `json:"randPoolSize,omitempty" yaml:"randPoolSize,omitempty"`
+    // Clock is the time source: "system" (the default) reads the wall
+    // clock, "monotonic" advances from the wall clock at startup using the
+    // monotonic clock, so later wall clock steps are not observed
+    Clock string `json:"clock,omitempty" yaml:"clock,omitempty"`
+    // RegressionPolicy is "bump" (the default), "stall" or "error"
+    RegressionPolicy string `json:"regressionPolicy,omitempty" yaml:"regressionPolicy,omitempty"`
+    // Profile is the name of a built-in profile to validate output against
+    Profile string `json:"profile,omitempty" yaml:"profile,omitempty"`
+    // Namespace and Name are the inputs of version 3 and 5 generators
+    Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+    Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+    // Stats enables WithStats
+    Stats bool `json:"stats,omitempty" yaml:"stats,omitempty"`
+}
+
+// FromConfig creates a generator from a declarative configuration. Extra
+// options are applied after the ones derived from cfg.
+func FromConfig(cfg GeneratorConfig, opts ...Option) (*UUIDGenerator, error) {
+    version := Version(cfg.Version)
+    var configured []Option
+
+    if cfg.Monotonic && version != VersionUnixTime {
+        return nil, fmt.Errorf("monotonic ordering requires version 7, got %d", version)
+    }
+    if cfg.SubMillisecond {
+        if version != VersionUnixTime {
+            return nil, fmt.Errorf("sub-millisecond precision requires version 7, got %d", version)
+        }
+        configured = append(configured, WithSubMillisecondPrecision())
+    }
+
+    switch cfg.Node {
+    case "":
+    case "random":
+        configured = append(configured, WithRandomNode())
+    default:
+        node, err := parseNode(cfg.Node)
+        if err != nil {
+            return nil, err
+        }
+        configured = append(configured, func(g *UUIDGenerator) {
+            g.node = node
+            g.nodeSet = true
+        })
+    }
+
+    if cfg.RandPoolSize < 0 {
+        return nil, fmt.Errorf("invalid rand pool size: %d", cfg.RandPoolSize)
+    }
+    if cfg.RandPoolSize > 0 {
+        size := cfg.RandPoolSize
+        configured = append(configured, func(g *UUIDGenerator) {
+            g.rand = &pooledReader{r: bufio.NewReaderSize(g.random(), size)}
+        })
+    }
+
+    switch cfg.Clock {
+    case "", "system":
+    case "monotonic":
+        configured = append(configured, WithClock(newMonotonicClock()))
+    default:
+        return nil, fmt.Errorf("unknown clock source: %q", cfg.Clock)
+    }
+
+    switch cfg.RegressionPolicy {
+    case "", "bump":
+    case "stall":
+        configured = append(configured, WithRegressionPolicy(RegressionStall))
+    case "error":
+        configured = append(configured, WithRegressionPolicy(RegressionError))
+    default:
+        return nil, fmt.Errorf("unknown regression policy: %q", cfg.RegressionPolicy)
+    }
+
+    if cfg.Profile != "" {
+        p, ok := LookupProfile(cfg.Profile)
+        if !ok {
+            return nil, fmt.Errorf("unknown profile: %q", cfg.Profile)
+        }
+        configured = append(configured, WithProfile(p))
+    }
+
+    if cfg.Namespace != "" || cfg.Name != "" {
+        namespace, err := Parse(cfg.Namespace)
+        if err != nil {
+            return nil, fmt.Errorf("invalid namespace: %w", err)
+        }
+        configured = append(configured, WithName(namespace, cfg.Name))
+    }
+
+    if cfg.Stats {
+        configured = append(configured, WithStats())
+    }
+
+    return NewGenerator(version, append(configured, opts...)...)
+}
+
+// parseNode parses a 48-bit node ID written as hex digits, optionally
+// separated by colons or hyphens
+func parseNode(s string) ([6]byte, error) {
+    var node [6]byte
+    digits := strings.NewReplacer(":", "", "-", "").Replace(s)
+    if len(digits) != 12 {
+        return node, fmt.Errorf("invalid node ID: %q", s)
+    }
+    if _, err := hex.Decode(node[:], []byte(digits)); err != nil {
+        return node, fmt.Errorf("invalid node ID: %q", s)
+    }
+    return node, nil
+}
+
+// pooledReader serializes reads from a buffered randomness source
+type pooledReader struct {
+    mu sync.Mutex
+    r  *bufio.Reader
+}
+
+func (p *pooledReader) Read(b []byte) (int, error) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    return io.ReadFull(p.r, b)
+}
+
+// monotonicClock reports the wall time at its creation advanced by the
+// monotonic time elapsed since
+type monotonicClock struct {
+    start time.Time
+}
+
+func newMonotonicClock() *monotonicClock {
+    return &monotonicClock{start: time.Now()}
+}
+
+func (c *monotonicClock) Now() time.Time {
+    return c.start.Add(time.Since(c.start)).Round(0)
+}
@@ -0,0 +1,102 @@
+package uuid
+
+import (
+    "bytes"
+    "encoding/json"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestFromConfigJSON(t *testing.T) {
+    var cfg GeneratorConfig
+    err := json.Unmarshal([]byte(`{
+        "version": 7,
+        "monotonic": true,
+        "subMillisecond": true,
+        "randPoolSize": 4096,
+        "clock": "monotonic",
+        "regressionPolicy": "error",
+        "profile": "strict-rfc9562",
+        "stats": true
+    }`), &cfg)
+    require.NoError(t, err)
+
+    gen, err := FromConfig(cfg)
+    require.NoError(t, err)
+    assert.Equal(t, VersionUnixTime, gen.Version())
+
+    prev := Nil
+    for i := 0; i < 1000; i++ {
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, 1, uuid.Compare(prev))
+        prev = uuid
+    }
+    assert.Equal(t, uint64(1000), gen.Stats().Generated)
+}
+
+func TestFromConfigNode(t *testing.T) {
+    gen, err := FromConfig(GeneratorConfig{Version: 1, Node: "01:23:45:67:89:ab"})
+    require.NoError(t, err)
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab}, uuid.NodeID())
+
+    gen, err = FromConfig(GeneratorConfig{Version: 6, Node: "random"})
+    require.NoError(t, err)
+    uuid, err = gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, byte(0x01), uuid.NodeID()[0]&0x01)
+}
+
+func TestFromConfigName(t *testing.T) {
+    gen, err := FromConfig(GeneratorConfig{
+        Version:   5,
+        Namespace: NamespaceDNS.String(),
+        Name:      "example.com",
+    })
+    require.NoError(t, err)
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, NewV5(NamespaceDNS, "example.com"), uuid)
+}
+
+func TestFromConfigRandPool(t *testing.T) {
+    src := bytes.NewReader(bytes.Repeat([]byte{0xab}, 64))
+    gen, err := FromConfig(GeneratorConfig{Version: 4, RandPoolSize: 32}, WithRand(src))
+    require.NoError(t, err)
+    // WithRand passed after the config replaces the pooled source
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, VersionRandom, uuid.Version())
+}
+
+func TestFromConfigInvalid(t *testing.T) {
+    for name, cfg := range map[string]GeneratorConfig{
+        "version":    {Version: 2},
+        "monotonic":  {Version: 4, Monotonic: true},
+        "submilli":   {Version: 6, SubMillisecond: true},
+        "node":       {Version: 1, Node: "0123"},
+        "node hex":   {Version: 1, Node: "0123456789zz"},
+        "pool":       {Version: 4, RandPoolSize: -1},
+        "clock":      {Version: 7, Clock: "sundial"},
+        "regression": {Version: 7, RegressionPolicy: "panic"},
+        "profile":    {Version: 4, Profile: "nope"},
+        "namespace":  {Version: 5, Namespace: "bad", Name: "x"},
+        "name":       {Version: 3},
+    } {
+        _, err := FromConfig(cfg)
+        assert.Error(t, err, name)
+    }
+}
+
+func TestMonotonicClock(t *testing.T) {
+    c := newMonotonicClock()
+    a := c.Now()
+    b := c.Now()
+    assert.False(t, b.Before(a))
+    assert.WithinDuration(t, time.Now(), b, time.Second)
+}
@@ -0,0 +1,161 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "encoding/json"
+    "io"
+)
+
+// ConformanceSchema is the version of the ConformanceSuite layout. It is
+// bumped whenever fields are renamed or change meaning.
+const ConformanceSchema = 1
+
+// ConformanceSuite is a machine-readable set of inputs and expected outputs
+// covering every encoding and version this package supports, so ports to
+// other languages can check they match it byte for byte
+type ConformanceSuite struct {
+    Schema     int                `json:"schema"`
+    Encodings  []EncodingVector   `json:"encodings"`
+    Parsing    []ParseVector      `json:"parsing"`
+    Generation []GenerationVector `json:"generation"`
+}
+
+// EncodingVector lists every representation of one UUID
+type EncodingVector struct {
+    // Bytes is the 16-byte binary form in hex
+    Bytes     string `json:"bytes"`
+    Canonical string `json:"canonical"`
+    URN       string `json:"urn"`
+    Base58    string `json:"base58"`
+    ULID      string `json:"ulid"`
+    // Envelope is the 17-byte envelope in hex
+    Envelope string `json:"envelope"`
+    Color    string `json:"color"`
+    Version  int    `json:"version"`
+    Variant  string `json:"variant"`
+}
+
+// ParseVector is an input to Parse and its expected result
+type ParseVector struct {
+    Input string `json:"input"`
+    Valid bool   `json:"valid"`
+    // UUID is the canonical form of the result when Valid is true
+    UUID string `json:"uuid,omitempty"`
+}
+
+// GenerationVector is a UUID built from fixed field values, with the
+// inputs named after the fields of RFC 9562 and given in hex
+type GenerationVector struct {
+    Version int               `json:"version"`
+    Inputs  map[string]string `json:"inputs"`
+    UUID    string            `json:"uuid"`
+}
+
+// conformanceUUIDs are the UUIDs whose encodings are listed
+var conformanceUUIDs = []string{
+    "00000000-0000-0000-0000-000000000000",
+    "ffffffff-ffff-ffff-ffff-ffffffffffff",
+    "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+    "5df41881-3aed-3515-88a7-2f4a814cf09e",
+    "919108f7-52d1-4320-9bac-f847db4148a8",
+    "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+    "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+    "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0",
+    "00112233-4455-6677-c899-aabbccddeeff",
+}
+
+// conformanceParsing are the parse vectors of the suite, with their
+// expected results written out by hand
+var conformanceParsing = []ParseVector{
+    {Input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "f81d4fae7dec11d0a76500a0c91e6bf6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "URN:UUID:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", Valid: true, UUID: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: ""},
+    {Input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf"},
+    {Input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6a"},
+    {Input: "g81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "f81d4fae-7dec-11d0-a765_00a0c91e6bf6"},
+    {Input: "f81d4fae7-dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+    {Input: "urn:oid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+}
+
+// Conformance returns the conformance suite of this package. The
+// generation vectors are the test vectors of RFC 9562 Appendices A and B.
+func Conformance() ConformanceSuite {
+    suite := ConformanceSuite{Schema: ConformanceSchema}
+
+    for _, s := range conformanceUUIDs {
+        u := MustParse(s)
+        suite.Encodings = append(suite.Encodings, EncodingVector{
+            Bytes:     hex.EncodeToString(u[:]),
+            Canonical: u.String(),
+            URN:       u.URN(),
+            Base58:    u.ToBase58(),
+            ULID:      u.ToULID(),
+            Envelope:  hex.EncodeToString(u.Envelope()),
+            Color:     u.ColorHex(),
+            Version:   int(u.Version()),
+            Variant:   variantName(u.Variant()),
+        })
+    }
+
+    suite.Parsing = append([]ParseVector(nil), conformanceParsing...)
+
+    suite.Generation = conformanceGeneration()
+    return suite
+}
+
+// WriteConformance writes the conformance suite to w as indented JSON
+func WriteConformance(w io.Writer) error {
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(Conformance())
+}
+
+func conformanceGeneration() []GenerationVector {
+    var vectors []GenerationVector
+    add := func(version Version, inputs map[string]string, u UUID) {
+        vectors = append(vectors, GenerationVector{Version: int(version), Inputs: inputs, UUID: u.String()})
+    }
+
+    // A.1 and A.5: 2022-02-22T19:22:22Z in 100ns intervals since the
+    // Gregorian epoch, with a fixed clock sequence and node
+    node := [6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}
+    var v1, v6 UUID
+    putV1Time(&v1, 0x1ec9414c232ab00)
+    putClockSeqAndNode(&v1, 0x33c8, node)
+    putV6Time(&v6, 0x1ec9414c232ab00)
+    putClockSeqAndNode(&v6, 0x33c8, node)
+    add(VersionTimeBased, map[string]string{"timestamp": "1ec9414c232ab00", "clock_seq": "33c8", "node": "9f6bdeced846"}, v1)
+    add(VersionReorderedTime, map[string]string{"timestamp": "1ec9414c232ab00", "clock_seq": "33c8", "node": "9f6bdeced846"}, v6)
+
+    // A.2 and A.4
+    dns := hex.EncodeToString(NamespaceDNS[:])
+    name := hex.EncodeToString([]byte("www.example.com"))
+    add(VersionNameBasedMD5, map[string]string{"namespace": dns, "name": name}, NewV3(NamespaceDNS, "www.example.com"))
+    add(VersionNameBasedSHA1, map[string]string{"namespace": dns, "name": name}, NewV5(NamespaceDNS, "www.example.com"))
+
+    // A.3: random bits with the version and variant overwritten
+    random := [16]byte{0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x33, 0x20, 0x5b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8}
+    v4 := UUID(random)
+    v4[6] = (v4[6] & 0x0f) | 0x40 // Version 4
+    v4[8] = (v4[8] & 0x3f) | 0x80 // Variant RFC4122
+    add(VersionRandom, map[string]string{"random": hex.EncodeToString(random[:])}, v4)
+
+    // A.6: the low 62 bits of rand_b are bytes 8-15 below the variant
+    v7 := UUID{8: 0x18, 9: 0xc4, 10: 0xdc, 11: 0x0c, 12: 0x0c, 13: 0x07, 14: 0x39, 15: 0x8f}
+    putV7Fields(&v7, 0x017f22e279b0<<12|0xcc3)
+    add(VersionUnixTime, map[string]string{"unix_ts_ms": "017f22e279b0", "rand_a": "cc3", "rand_b": "18c4dc0c0c07398f"}, v7)
+
+    // B.2: custom data with the version and variant overwritten
+    custom := [16]byte{0x24, 0x89, 0xe9, 0xad, 0x2e, 0xe2, 0x0e, 0x00, 0x0e, 0xc9, 0x32, 0xd5, 0xf6, 0x91, 0x81, 0xc0}
+    add(VersionCustom, map[string]string{"custom": hex.EncodeToString(custom[:])}, NewV8(custom))
+
+    return vectors
+}
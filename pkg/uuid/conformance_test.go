@@ -0,0 +1,79 @@
+package uuid
+
+import (
+    "bytes"
+    "encoding/hex"
+    "encoding/json"
+    "os"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestConformanceGenerationRFC9562(t *testing.T) {
+    want := map[int]string{
+        1: "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+        3: "5df41881-3aed-3515-88a7-2f4a814cf09e",
+        4: "919108f7-52d1-4320-9bac-f847db4148a8",
+        5: "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+        6: "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+        7: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+        8: "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0",
+    }
+    suite := Conformance()
+    require.Len(t, suite.Generation, len(want))
+    for _, v := range suite.Generation {
+        assert.Equal(t, want[v.Version], v.UUID, "version %d", v.Version)
+        assert.Equal(t, v.Version, int(MustParse(v.UUID).Version()))
+    }
+}
+
+func TestConformanceEncodings(t *testing.T) {
+    for _, v := range Conformance().Encodings {
+        u, err := Parse(v.Canonical)
+        require.NoError(t, err)
+        assert.Equal(t, v.Bytes, hex.EncodeToString(u[:]))
+
+        back, err := FromBase58(v.Base58)
+        require.NoError(t, err)
+        assert.Equal(t, u, back)
+
+        back, err = decodeULID(v.ULID)
+        require.NoError(t, err)
+        assert.Equal(t, u, back)
+
+        env, err := hex.DecodeString(v.Envelope)
+        require.NoError(t, err)
+        back, err = ParseEnvelope(env)
+        require.NoError(t, err)
+        assert.Equal(t, u, back)
+    }
+}
+
+func TestConformanceParsing(t *testing.T) {
+    data, err := os.ReadFile("testdata/conformance_parsing.json")
+    require.NoError(t, err)
+    var golden []ParseVector
+    require.NoError(t, json.Unmarshal(data, &golden))
+    require.NotEmpty(t, golden)
+    assert.Equal(t, golden, Conformance().Parsing)
+
+    for _, v := range golden {
+        u, err := Parse(v.Input)
+        assert.Equal(t, v.Valid, err == nil, v.Input)
+        if v.Valid {
+            assert.Equal(t, v.UUID, u.String(), v.Input)
+        }
+    }
+}
+
+func TestWriteConformance(t *testing.T) {
+    var buf bytes.Buffer
+    require.NoError(t, WriteConformance(&buf))
+
+    var suite ConformanceSuite
+    require.NoError(t, json.Unmarshal(buf.Bytes(), &suite))
+    assert.Equal(t, Conformance(), suite)
+    assert.Equal(t, ConformanceSchema, suite.Schema)
+}
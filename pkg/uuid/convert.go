@@ -0,0 +1,191 @@
+package uuid
+
+import (
+    "bufio"
+    "bytes"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "io"
+)
+
+// Format is a representation of a UUID handled by ConvertAll
+type Format int
+
+const (
+    // FormatCanonical is the 8-4-4-4-12 text form, one UUID per line. Any
+    // form accepted by Parse is read.
+    FormatCanonical Format = iota
+    // FormatHex is 32 hex digits without hyphens, one UUID per line
+    FormatHex
+    // FormatBase58 is the ToBase58 encoding, one UUID per line
+    FormatBase58
+    // FormatULID is the 26-character ULID form, one UUID per line. All 128
+    // bits are carried over unchanged, unlike FromULID.
+    FormatULID
+    // FormatBinary is a stream of raw 16-byte UUIDs
+    FormatBinary
+)
+
+var formatNames = [...]string{"canonical", "hex", "base58", "ulid", "binary"}
+
+func (f Format) String() string {
+    if f >= 0 && int(f) < len(formatNames) {
+        return formatNames[f]
+    }
+    return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// ParseFormat returns the format with the given name, as printed by
+// Format.String
+func ParseFormat(name string) (Format, error) {
+    for i, n := range formatNames {
+        if n == name {
+            return Format(i), nil
+        }
+    }
+    return 0, fmt.Errorf("unknown format: %q", name)
+}
+
+// ConvertError reports a single record that could not be converted
+type ConvertError struct {
+    // Record is the 1-based line number, or record number for binary input
+    Record int
+    Value  string
+    Err    error
+}
+
+func (e ConvertError) Error() string {
+    return fmt.Sprintf("record %d: %q: %v", e.Record, e.Value, e.Err)
+}
+
+func (e ConvertError) Unwrap() error {
+    return e.Err
+}
+
+// ConvertReport summarizes a ConvertAll run
+type ConvertReport struct {
+    Records   int
+    Converted int
+    Errors    []ConvertError
+}
+
+// ConvertAll streams UUIDs from input in one format to w in another.
+// Records that cannot be decoded are skipped and collected in the report;
+// the returned error is only non-nil when reading or writing fails.
+func ConvertAll(input io.Reader, inFormat, outFormat Format, w io.Writer) (ConvertReport, error) {
+    var report ConvertReport
+    if inFormat < 0 || int(inFormat) >= len(formatNames) {
+        return report, fmt.Errorf("unknown format: %d", inFormat)
+    }
+    if outFormat < 0 || int(outFormat) >= len(formatNames) {
+        return report, fmt.Errorf("unknown format: %d", outFormat)
+    }
+
+    bw := bufio.NewWriter(w)
+    emit := func(uuid UUID) error {
+        report.Converted++
+        if outFormat == FormatBinary {
+            _, err := bw.Write(uuid[:])
+            return err
+        }
+        _, err := bw.WriteString(encodeFormat(uuid, outFormat))
+        if err == nil {
+            err = bw.WriteByte('\n')
+        }
+        return err
+    }
+    fail := func(record int, value string, err error) {
+        report.Errors = append(report.Errors, ConvertError{Record: record, Value: value, Err: err})
+    }
+
+    var err error
+    if inFormat == FormatBinary {
+        err = convertBinary(input, &report, emit, fail)
+    } else {
+        err = convertText(input, inFormat, &report, emit, fail)
+    }
+    if err != nil {
+        return report, err
+    }
+    return report, bw.Flush()
+}
+
+func convertText(r io.Reader, format Format, report *ConvertReport, emit func(UUID) error, fail func(int, string, error)) error {
+    scanner := bufio.NewScanner(r)
+    for line := 1; scanner.Scan(); line++ {
+        raw := bytes.TrimSpace(scanner.Bytes())
+        if len(raw) == 0 {
+            continue
+        }
+        report.Records++
+        uuid, err := decodeFormat(string(raw), format)
+        if err != nil {
+            fail(line, string(raw), err)
+            continue
+        }
+        if err := emit(uuid); err != nil {
+            return err
+        }
+    }
+    return scanner.Err()
+}
+
+func convertBinary(r io.Reader, report *ConvertReport, emit func(UUID) error, fail func(int, string, error)) error {
+    br := bufio.NewReader(r)
+    var buf UUID
+    for record := 1; ; record++ {
+        n, err := io.ReadFull(br, buf[:])
+        if errors.Is(err, io.EOF) {
+            return nil
+        }
+        report.Records++
+        if errors.Is(err, io.ErrUnexpectedEOF) {
+            fail(record, fmt.Sprintf("%x", buf[:n]), fmt.Errorf("truncated record of %d bytes", n))
+            return nil
+        }
+        if err != nil {
+            return err
+        }
+        if err := emit(buf); err != nil {
+            return err
+        }
+    }
+}
+
+// decodeFormat decodes a single textual record
+func decodeFormat(s string, format Format) (UUID, error) {
+    switch format {
+    case FormatCanonical:
+        return Parse(s)
+    case FormatHex:
+        var uuid UUID
+        if len(s) != 2*Size {
+            return Nil, fmt.Errorf("%w: %d hex digits", ErrInvalidLength, len(s))
+        }
+        if _, err := hex.Decode(uuid[:], []byte(s)); err != nil {
+            return Nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+        }
+        return uuid, nil
+    case FormatBase58:
+        return FromBase58(s)
+    case FormatULID:
+        return decodeULID(s)
+    default:
+        return Nil, fmt.Errorf("unsupported text format: %v", format)
+    }
+}
+
+// encodeFormat encodes uuid in a textual format
+func encodeFormat(uuid UUID, format Format) string {
+    switch format {
+    case FormatHex:
+        return hex.EncodeToString(uuid[:])
+    case FormatBase58:
+        return uuid.ToBase58()
+    case FormatULID:
+        return uuid.ToULID()
+    default:
+        return uuid.String()
+    }
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestConvertAllRoundTrip(t *testing.T) {
+    ids := []UUID{Nil, maxUUID, NewV5(NamespaceDNS, "example.com"), New()}
+    var canonical bytes.Buffer
+    for _, id := range ids {
+        canonical.WriteString(id.String() + "\n")
+    }
+
+    for _, format := range []Format{FormatHex, FormatBase58, FormatULID, FormatBinary} {
+        var encoded, decoded bytes.Buffer
+        report, err := ConvertAll(bytes.NewReader(canonical.Bytes()), FormatCanonical, format, &encoded)
+        require.NoError(t, err, format)
+        assert.Equal(t, len(ids), report.Converted, format)
+
+        report, err = ConvertAll(&encoded, format, FormatCanonical, &decoded)
+        require.NoError(t, err, format)
+        assert.Empty(t, report.Errors, format)
+        assert.Equal(t, canonical.String(), decoded.String(), format)
+    }
+}
+
+func TestConvertAllErrors(t *testing.T) {
+    input := "550e8400-e29b-41d4-a716-446655440000\n\nnot-a-uuid\n{6ba7b810-9dad-11d1-80b4-00c04fd430c8}\n"
+    var out bytes.Buffer
+    report, err := ConvertAll(strings.NewReader(input), FormatCanonical, FormatHex, &out)
+    require.NoError(t, err)
+    assert.Equal(t, 3, report.Records)
+    assert.Equal(t, 2, report.Converted)
+    require.Len(t, report.Errors, 1)
+    assert.Equal(t, 3, report.Errors[0].Record)
+    assert.Equal(t, "not-a-uuid", report.Errors[0].Value)
+    assert.Equal(t, "550e8400e29b41d4a716446655440000\n6ba7b8109dad11d180b400c04fd430c8\n", out.String())
+}
+
+func TestConvertAllTruncatedBinary(t *testing.T) {
+    var out bytes.Buffer
+    report, err := ConvertAll(bytes.NewReader(make([]byte, 20)), FormatBinary, FormatCanonical, &out)
+    require.NoError(t, err)
+    assert.Equal(t, 2, report.Records)
+    assert.Equal(t, 1, report.Converted)
+    assert.Len(t, report.Errors, 1)
+}
+
+func TestConvertAllUnknownFormat(t *testing.T) {
+    _, err := ConvertAll(strings.NewReader(""), Format(9), FormatHex, &bytes.Buffer{})
+    assert.Error(t, err)
+}
+
+func TestParseFormat(t *testing.T) {
+    for _, f := range []Format{FormatCanonical, FormatHex, FormatBase58, FormatULID, FormatBinary} {
+        got, err := ParseFormat(f.String())
+        require.NoError(t, err)
+        assert.Equal(t, f, got)
+    }
+    _, err := ParseFormat("base64")
+    assert.Error(t, err)
+    assert.Equal(t, "Format(9)", Format(9).String())
+}
@@ -0,0 +1,105 @@
+package uuid
+
+import (
+    "encoding/binary"
+    "errors"
+    "io"
+    "os"
+    "sync"
+)
+
+// V7Coordinator allocates V7 timestamp and counter values shared by
+// several generators, such as worker processes on one host, so that their
+// UUIDs are strictly increasing as a whole and never collide within a
+// millisecond. Values are packed as ms<<12 | counter, the layout of the
+// 60 bits preceding the variant.
+type V7Coordinator interface {
+    // Allocate returns candidate if it sorts after every value allocated
+    // so far, or else the successor of the last allocated value
+    Allocate(candidate uint64) (uint64, error)
+}
+
+// WithCoordinator makes a V7 generator allocate every timestamp and
+// counter through c, e.g. a FileCoordinator shared by worker processes
+func WithCoordinator(c V7Coordinator) Option {
+    return func(g *UUIDGenerator) {
+        g.coordinator = c
+    }
+}
+
+// errCoordinatorClosed is returned by a FileCoordinator after Close
+var errCoordinatorClosed = errors.New("coordinator is closed")
+
+// FileCoordinator is a V7Coordinator backed by a small file holding the
+// last allocated value. Every allocation takes an exclusive advisory lock
+// on the file, so all processes on a host that open the same path share
+// one monotonic sequence. It is supported on Unix systems only; elsewhere
+// Allocate fails.
+type FileCoordinator struct {
+    mu     sync.Mutex
+    f      *os.File
+    closed bool
+}
+
+// NewFileCoordinator opens the coordination file at path, creating it if
+// it does not exist
+func NewFileCoordinator(path string) (*FileCoordinator, error) {
+    f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+    if err != nil {
+        return nil, err
+    }
+    return &FileCoordinator{f: f}, nil
+}
+
+// Allocate implements V7Coordinator
+func (c *FileCoordinator) Allocate(candidate uint64) (uint64, error) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    if c.closed {
+        return 0, errCoordinatorClosed
+    }
+
+    if err := lockFile(c.f); err != nil {
+        return 0, err
+    }
+    next, err := c.allocate(candidate)
+    if uerr := unlockFile(c.f); err == nil {
+        err = uerr
+    }
+    return next, err
+}
+
+// allocate reads, advances and writes back the shared value. Callers must
+// hold the file lock.
+func (c *FileCoordinator) allocate(candidate uint64) (uint64, error) {
+    var buf [8]byte
+    n, err := c.f.ReadAt(buf[:], 0)
+    if err != nil && !errors.Is(err, io.EOF) {
+        return 0, err
+    }
+
+    next := candidate
+    // A short file is a fresh one, or one whose first write was cut short
+    if n == len(buf) {
+        if last := binary.BigEndian.Uint64(buf[:]); next <= last {
+            next = last + 1
+        }
+    }
+
+    binary.BigEndian.PutUint64(buf[:], next)
+    if _, err := c.f.WriteAt(buf[:], 0); err != nil {
+        return 0, err
+    }
+    return next, nil
+}
+
+// Close closes the coordination file. It is safe to call more than once.
+func (c *FileCoordinator) Close() error {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    if c.closed {
+        return nil
+    }
+    c.closed = true
+    return c.f.Close()
+}
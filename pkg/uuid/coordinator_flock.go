@@ -0,0 +1,26 @@
+//go:build unix && !tinygo
+
+package uuid
+
+import (
+    "os"
+    "syscall"
+)
+
+// fileLockSupported reports whether FileCoordinator works on this target
+const fileLockSupported = true
+
+// lockFile takes an exclusive advisory lock on f, blocking until it is
+// available
+func lockFile(f *os.File) error {
+    for {
+        err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+        if err != syscall.EINTR {
+            return err
+        }
+    }
+}
+
+func unlockFile(f *os.File) error {
+    return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,23 @@
+//go:build !unix || tinygo
+
+package uuid
+
+import (
+    "errors"
+    "os"
+)
+
+// fileLockSupported reports whether FileCoordinator works on this target
+const fileLockSupported = false
+
+var errNoFileLock = errors.New("file locking is not supported on this platform")
+
+// lockFile fails on platforms without flock, as the FileCoordinator
+// cannot guarantee mutual exclusion there
+func lockFile(f *os.File) error {
+    return errNoFileLock
+}
+
+func unlockFile(f *os.File) error {
+    return errNoFileLock
+}
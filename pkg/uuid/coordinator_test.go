@@ -0,0 +1,81 @@
+//go:build unix && !tinygo
+
+package uuid
+
+import (
+    "path/filepath"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestFileCoordinatorAllocate(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "v7.lock")
+    c, err := NewFileCoordinator(path)
+    require.NoError(t, err)
+    defer c.Close()
+
+    next, err := c.Allocate(100)
+    require.NoError(t, err)
+    assert.Equal(t, uint64(100), next)
+
+    next, err = c.Allocate(50)
+    require.NoError(t, err)
+    assert.Equal(t, uint64(101), next)
+
+    next, err = c.Allocate(200)
+    require.NoError(t, err)
+    assert.Equal(t, uint64(200), next)
+
+    // A second handle on the same file continues the sequence
+    other, err := NewFileCoordinator(path)
+    require.NoError(t, err)
+    next, err = other.Allocate(0)
+    require.NoError(t, err)
+    assert.Equal(t, uint64(201), next)
+    require.NoError(t, other.Close())
+    require.NoError(t, other.Close())
+
+    _, err = other.Allocate(0)
+    assert.ErrorIs(t, err, errCoordinatorClosed)
+}
+
+func TestFileCoordinatorGenerators(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "v7.lock")
+    frozen := ClockFunc(func() time.Time { return time.UnixMilli(1700000000000) })
+
+    // Each generator opens its own handle, as separate processes would
+    const workers, perWorker = 4, 200
+    results := make([][]UUID, workers)
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        c, err := NewFileCoordinator(path)
+        require.NoError(t, err)
+        defer c.Close()
+        gen := MustNewGenerator(VersionUnixTime, WithClock(frozen), WithCoordinator(c))
+
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            for i := 0; i < perWorker; i++ {
+                results[w] = append(results[w], Must(gen.Generate()))
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    seen := make(map[UUID]bool)
+    for _, ids := range results {
+        for i, u := range ids {
+            assert.False(t, seen[u], "duplicate %s", u)
+            seen[u] = true
+            if i > 0 {
+                assert.Equal(t, 1, u.Compare(ids[i-1]))
+            }
+        }
+    }
+    assert.Len(t, seen, workers*perWorker)
+}
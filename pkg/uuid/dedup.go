@@ -0,0 +1,83 @@
+package uuid
+
+import (
+    "fmt"
+    "sync"
+)
+
+// maxDedupAttempts bounds how often DedupGenerator regenerates before it
+// concludes the wrapped generator is broken
+const maxDedupAttempts = 8
+
+// DedupGenerator wraps a Generator and guarantees that none of the most
+// recently issued UUIDs is ever returned twice, regenerating on a repeat.
+// With a healthy generator a repeat is astronomically unlikely; this exists
+// for callers whose policy mandates the check. It is safe for concurrent use.
+type DedupGenerator struct {
+    gen Generator
+
+    mu     sync.Mutex
+    recent map[UUID]struct{}
+    ring   []UUID
+    next   int
+    hits   uint64
+}
+
+// NewDedupGenerator wraps gen, remembering the last size UUIDs issued
+func NewDedupGenerator(gen Generator, size int) *DedupGenerator {
+    if size < 1 {
+        size = 1
+    }
+    return &DedupGenerator{
+        gen:    gen,
+        recent: make(map[UUID]struct{}, size),
+        ring:   make([]UUID, 0, size),
+    }
+}
+
+// Generate returns a UUID that is not among the recently issued ones
+func (d *DedupGenerator) Generate() (UUID, error) {
+    for attempt := 0; attempt < maxDedupAttempts; attempt++ {
+        uuid, err := d.gen.Generate()
+        if err != nil {
+            return uuid, err
+        }
+        if d.remember(uuid) {
+            return uuid, nil
+        }
+    }
+    return Nil, fmt.Errorf("generator repeated UUIDs %d times in a row", maxDedupAttempts)
+}
+
+// remember records uuid as issued, returning false if it was a repeat
+func (d *DedupGenerator) remember(uuid UUID) bool {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+
+    if _, ok := d.recent[uuid]; ok {
+        d.hits++
+        return false
+    }
+
+    if len(d.ring) < cap(d.ring) {
+        d.ring = append(d.ring, uuid)
+    } else {
+        delete(d.recent, d.ring[d.next])
+        d.ring[d.next] = uuid
+        d.next = (d.next + 1) % len(d.ring)
+    }
+    d.recent[uuid] = struct{}{}
+    return true
+}
+
+// Version returns the wrapped generator's version
+func (d *DedupGenerator) Version() Version {
+    return d.gen.Version()
+}
+
+// Hits returns the number of repeats caught and regenerated
+func (d *DedupGenerator) Hits() uint64 {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    return d.hits
+}
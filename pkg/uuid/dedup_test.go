@@ -0,0 +1,48 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+// scriptedGenerator returns a fixed sequence of UUIDs
+type scriptedGenerator struct {
+    ids []UUID
+    pos int
+}
+
+func (s *scriptedGenerator) Generate() (UUID, error) {
+    uuid := s.ids[s.pos%len(s.ids)]
+    s.pos++
+    return uuid, nil
+}
+
+func (s *scriptedGenerator) Version() Version {
+    return VersionRandom
+}
+
+func TestDedupGenerator(t *testing.T) {
+    a, b, c := New(), New(), New()
+    d := NewDedupGenerator(&scriptedGenerator{ids: []UUID{a, a, b, a, c}}, 2)
+
+    assert.Equal(t, a, Must(d.Generate()))
+    assert.Equal(t, b, Must(d.Generate()))
+    assert.Equal(t, c, Must(d.Generate()))
+    assert.Equal(t, uint64(2), d.Hits())
+
+    // a has been evicted from the 2-entry window
+    assert.Equal(t, a, Must(d.Generate()))
+    assert.Equal(t, VersionRandom, d.Version())
+}
+
+func TestDedupGeneratorStuck(t *testing.T) {
+    a := New()
+    d := NewDedupGenerator(&scriptedGenerator{ids: []UUID{a}}, 4)
+
+    _, err := d.Generate()
+    require.NoError(t, err)
+    _, err = d.Generate()
+    assert.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package uuid
+
+import "sync/atomic"
+
+// defaultGenerator holds the generator used by New and MustNew
+var defaultGenerator atomic.Value
+
+// generatorHolder gives atomic.Value a single concrete type to store
+type generatorHolder struct {
+    gen Generator
+}
+
+// SetDefault routes New and MustNew through gen, e.g. a V7 generator with a
+// custom clock. A nil gen restores the V4 default. SetDefault is safe for
+// concurrent use.
+func SetDefault(gen Generator) {
+    if gen == nil {
+        gen = defaultRandomGenerator
+    }
+    defaultGenerator.Store(generatorHolder{gen: gen})
+}
+
+// Default returns the generator used by New and MustNew
+func Default() Generator {
+    if h, ok := defaultGenerator.Load().(generatorHolder); ok {
+        return h.gen
+    }
+    return defaultRandomGenerator
+}
@@ -0,0 +1,28 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestSetDefault(t *testing.T) {
+    defer SetDefault(nil)
+
+    v7 := MustNewGenerator(VersionUnixTime)
+    SetDefault(v7)
+    assert.Equal(t, Generator(v7), Default())
+    assert.Equal(t, VersionUnixTime, New().Version())
+    assert.Equal(t, VersionUnixTime, MustNew().Version())
+
+    SetDefault(nil)
+    assert.Equal(t, VersionRandom, New().Version())
+}
+
+func TestSetDefaultMustNewPanics(t *testing.T) {
+    defer SetDefault(nil)
+
+    SetDefault(MustNewGenerator(VersionRandom, WithRand(failingReader{})))
+    assert.Panics(t, func() { MustNew() })
+    assert.Equal(t, Nil, New())
+}
@@ -0,0 +1,159 @@
+package uuid
+
+import (
+    "encoding/binary"
+    "errors"
+    "hash/fnv"
+    "os"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// Degraded-mode marker bits: the six bits of rand_b after the variant and
+// all of the following byte are set
+const (
+    degradedMarker8 = 0xbf
+    degradedMarker9 = 0xff
+)
+
+var errEntropyStalled = errors.New("entropy source stalled")
+
+// WithDegradedMode lets a V7 generator keep issuing UUIDs when its
+// randomness source fails, or takes longer than stall to respond (if
+// stall is positive), instead of returning an error. Such degraded UUIDs
+// are built from the timestamp, a counter in rand_a that starts at zero
+// every millisecond (or the sub-millisecond fraction, with
+// WithSubMillisecondPrecision), and a salt in place of random bits. The
+// salt is fixed when the generator is created and hashes the host's
+// hardware address and name, the process ID, the creation time and a
+// per-process generator sequence, so generators that degrade at the same
+// time, as all processes on a host do when its entropy source stalls, do
+// not issue the same IDs. Degraded UUIDs are marked by setting the 14 bits
+// of rand_b that precede the salt, see Degraded, and counted in
+// Stats.Degraded.
+//
+// Degraded UUIDs are predictable and only unique per generator, so this
+// mode suits pipelines, such as telemetry, where availability
+// matters more than unpredictability. With a stall timeout, random bits are
+// read on a background goroutine, which Close stops; while a read that
+// outlasted the timeout is still pending, further calls degrade at once
+// rather than waiting again.
+func WithDegradedMode(stall time.Duration) Option {
+    return func(g *UUIDGenerator) {
+        g.degrade = true
+        g.stall = stall
+    }
+}
+
+// Degraded reports whether u carries the marker of a V7 UUID issued in
+// degraded mode (see WithDegradedMode). About one in 16384 regular V7
+// UUIDs carries the marker by chance.
+func (u UUID) Degraded() bool {
+    return u.Version() == VersionUnixTime && u[8] == degradedMarker8 && u[9] == degradedMarker9
+}
+
+// entropyFeed reads random bits for a degraded-mode generator with a
+// stall timeout on a long-lived background goroutine, so a slow read can
+// be abandoned without abandoning the bits it eventually returns
+type entropyFeed struct {
+    read func([]byte) error
+    ch   chan entropyBlock
+    done chan struct{}
+    stop sync.Once
+    // pending is set once a read has outlasted the stall timeout and
+    // cleared when it completes
+    pending atomic.Bool
+}
+
+type entropyBlock struct {
+    b   [Size]byte
+    err error
+}
+
+func (f *entropyFeed) start() {
+    f.ch = make(chan entropyBlock)
+    f.done = make(chan struct{})
+    go func() {
+        for {
+            var blk entropyBlock
+            blk.err = f.read(blk.b[:])
+            f.pending.Store(false)
+            select {
+            case f.ch <- blk:
+            case <-f.done:
+                return
+            }
+        }
+    }()
+}
+
+// close stops the background goroutine
+func (f *entropyFeed) close() {
+    f.stop.Do(func() { close(f.done) })
+}
+
+// readV7Entropy fills b, which holds at most Size bytes, with random bits,
+// giving up after the stall timeout if one is set
+func (g *UUIDGenerator) readV7Entropy(b []byte) error {
+    f := g.entropy
+    if f == nil {
+        return g.readRandom(b)
+    }
+
+    var blk entropyBlock
+    select {
+    case blk = <-f.ch:
+    default:
+        // A read that already stalled is not waited for again
+        if f.pending.Load() {
+            return errEntropyStalled
+        }
+        timer := time.NewTimer(g.stall)
+        defer timer.Stop()
+        select {
+        case blk = <-f.ch:
+        case <-f.done:
+            return g.readRandom(b)
+        case <-timer.C:
+            f.pending.Store(true)
+            return errEntropyStalled
+        }
+    }
+    copy(b, blk.b[:])
+    return blk.err
+}
+
+// markDegraded writes the degraded-mode marker and salt into the rand_b
+// field of a V7 UUID. Callers must hold g.mu.
+func (g *UUIDGenerator) markDegraded(uuid *UUID) {
+    uuid[8] = degradedMarker8
+    uuid[9] = degradedMarker9
+    copy(uuid[10:], g.degradedSalt[:])
+    g.degraded++
+}
+
+// degradedSeq numbers the generators created with WithDegradedMode
+var degradedSeq atomic.Uint64
+
+// newDegradedSalt derives the salt of a degraded-mode generator without
+// consulting the randomness source, with the multicast bit set as for
+// random node IDs
+func newDegradedSalt() [6]byte {
+    h := fnv.New64a()
+    hw, _ := interfaceAddr("")
+    h.Write(hw[:])
+    name, _ := os.Hostname()
+    h.Write([]byte(name))
+
+    var b [8]byte
+    for _, v := range []uint64{uint64(os.Getpid()), uint64(time.Now().UnixNano()), degradedSeq.Add(1)} {
+        binary.BigEndian.PutUint64(b[:], v)
+        h.Write(b[:])
+    }
+
+    var salt [6]byte
+    copy(salt[:], h.Sum(nil))
+    salt[0] |= 0x01
+    return salt
+}
@@ -0,0 +1,109 @@
+package uuid
+
+import (
+    "crypto/rand"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+// blockingReader blocks every read until release is closed
+type blockingReader struct {
+    release chan struct{}
+}
+
+func (r blockingReader) Read(p []byte) (int, error) {
+    <-r.release
+    return rand.Read(p)
+}
+
+func TestDegradedModeOnError(t *testing.T) {
+    _, err := MustNewGenerator(VersionUnixTime, WithRand(failingReader{})).Generate()
+    assert.Error(t, err)
+
+    gen := MustNewGenerator(VersionUnixTime, WithRand(failingReader{}), WithDegradedMode(0))
+    var prev UUID
+    for i := 0; i < 5; i++ {
+        u, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, VersionUnixTime, u.Version())
+        assert.Equal(t, VariantRFC4122, u.Variant())
+        assert.True(t, u.Degraded())
+        if i > 0 {
+            assert.Equal(t, 1, u.Compare(prev))
+        }
+        prev = u
+    }
+
+    assert.Equal(t, gen.degradedSalt[:], prev[10:])
+    assert.Equal(t, uint64(5), gen.Stats().Degraded)
+}
+
+func TestDegradedModeDistinctGenerators(t *testing.T) {
+    clock := ClockFunc(func() time.Time { return time.UnixMilli(1767000000000) })
+    a := MustNewGenerator(VersionUnixTime, WithClock(clock), WithRand(failingReader{}), WithDegradedMode(0))
+    b := MustNewGenerator(VersionUnixTime, WithClock(clock), WithRand(failingReader{}), WithDegradedMode(0))
+
+    ua, err := a.Generate()
+    require.NoError(t, err)
+    ub, err := b.Generate()
+    require.NoError(t, err)
+    assert.True(t, ua.Degraded())
+    assert.True(t, ub.Degraded())
+    assert.Equal(t, ua[:10], ub[:10])
+    assert.NotEqual(t, ua, ub)
+}
+
+func TestDegradedModeOnStall(t *testing.T) {
+    r := blockingReader{release: make(chan struct{})}
+    gen := MustNewGenerator(VersionUnixTime, WithRand(r), WithDegradedMode(10*time.Millisecond))
+
+    start := time.Now()
+    a := Must(gen.Generate())
+    assert.True(t, a.Degraded())
+
+    // The first read is still pending, so this one does not wait
+    b := Must(gen.Generate())
+    assert.True(t, b.Degraded())
+    assert.Equal(t, 1, b.Compare(a))
+    assert.Less(t, time.Since(start), time.Second)
+
+    close(r.release)
+    require.Eventually(t, func() bool { return !gen.entropy.pending.Load() }, time.Second, time.Millisecond)
+    c := Must(gen.Generate())
+    assert.Equal(t, 1, c.Compare(b))
+    assert.Equal(t, uint64(2), gen.Stats().Degraded)
+}
+
+func TestDegradedModeHealthySource(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime, WithDegradedMode(time.Second))
+    defer gen.Close()
+
+    var wg sync.WaitGroup
+    for i := 0; i < 8; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for j := 0; j < 2000; j++ {
+                // Regular UUIDs carry the marker by chance, but not the salt
+                if u := Must(gen.Generate()); u.Degraded() && [6]byte(u[10:]) == gen.degradedSalt {
+                    t.Errorf("degraded UUID %s from a healthy source", u)
+                    return
+                }
+            }
+        }()
+    }
+    wg.Wait()
+    assert.Zero(t, gen.Stats().Degraded)
+}
+
+func TestDegradedMarker(t *testing.T) {
+    assert.False(t, MustParse("01890a5d-ac96-774b-bcce-b302099a8057").Degraded())
+    assert.True(t, MustParse("01890a5d-ac96-7000-bfff-010203040506").Degraded())
+    assert.False(t, MustParse("01890a5d-ac96-4000-bfff-010203040506").Degraded())
+    assert.Equal(t, byte(0x01), newDegradedSalt()[0]&0x01)
+    assert.NotEqual(t, newDegradedSalt(), newDegradedSalt())
+}
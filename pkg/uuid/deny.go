@@ -0,0 +1,183 @@
+package uuid
+
+import (
+    "bufio"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "strings"
+    "sync"
+    "sync/atomic"
+)
+
+// maxDenyAttempts bounds how many times a generator regenerates a UUID
+// that is on the deny list before giving up with ErrDenied
+const maxDenyAttempts = 16
+
+// ErrDenied is returned when a UUID is on the active deny list
+var ErrDenied = errors.New("UUID is on the deny list")
+
+// DenyList is a set of UUIDs and ranges that must never be issued or
+// accepted, such as reserved or known-compromised IDs. It is safe for
+// concurrent use.
+type DenyList struct {
+    mu     sync.RWMutex
+    ids    map[UUID]struct{}
+    ranges []Range
+}
+
+// NewDenyList creates a deny list holding the Nil and Max UUIDs
+func NewDenyList() *DenyList {
+    d := &DenyList{ids: make(map[UUID]struct{})}
+    d.Add(Nil)
+    d.Add(maxUUID)
+    return d
+}
+
+// Add denies a single UUID
+func (d *DenyList) Add(u UUID) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    d.ids[u] = struct{}{}
+}
+
+// AddRange denies every UUID in r
+func (d *DenyList) AddRange(r Range) {
+    d.mu.Lock()
+    defer d.mu.Unlock()
+    d.ranges = append(d.ranges, r)
+}
+
+// Denies reports whether u is on the list
+func (d *DenyList) Denies(u UUID) bool {
+    d.mu.RLock()
+    defer d.mu.RUnlock()
+    if _, ok := d.ids[u]; ok {
+        return true
+    }
+    for _, r := range d.ranges {
+        if r.Contains(u) {
+            return true
+        }
+    }
+    return false
+}
+
+// Check returns an error wrapping ErrDenied if u is on the list
+func (d *DenyList) Check(u UUID) error {
+    if d.Denies(u) {
+        return fmt.Errorf("%w: %s", ErrDenied, u)
+    }
+    return nil
+}
+
+// LoadDenyList reads a deny list with one entry per line: a UUID, an
+// inclusive range written as two UUIDs separated by "..", or a hex prefix
+// ending in "*" as accepted by MatchPrefixHex. Blank lines and lines
+// starting with # are ignored. The Nil and Max UUIDs are always included.
+//
+//	# reserved for the billing team
+//	0b1e0000-*
+//	00000000-0000-0000-0000-000000000001..00000000-0000-0000-0000-0000000000ff
+func LoadDenyList(r io.Reader) (*DenyList, error) {
+    d := NewDenyList()
+    scanner := bufio.NewScanner(r)
+    line := 0
+    for scanner.Scan() {
+        line++
+        entry := strings.TrimSpace(scanner.Text())
+        if entry == "" || entry[0] == '#' {
+            continue
+        }
+        if err := d.addEntry(entry); err != nil {
+            return nil, fmt.Errorf("deny list line %d: %w", line, err)
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    return d, nil
+}
+
+// LoadDenyListFile reads a deny list from the file at path, see
+// LoadDenyList
+func LoadDenyListFile(path string) (*DenyList, error) {
+    f, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    return LoadDenyList(f)
+}
+
+func (d *DenyList) addEntry(entry string) error {
+    if prefix, ok := strings.CutSuffix(entry, "*"); ok {
+        r, err := MatchPrefixHex(prefix)
+        if err != nil {
+            return err
+        }
+        d.AddRange(r)
+        return nil
+    }
+    if lo, hi, ok := strings.Cut(entry, ".."); ok {
+        min, err := Parse(strings.TrimSpace(lo))
+        if err != nil {
+            return err
+        }
+        max, err := Parse(strings.TrimSpace(hi))
+        if err != nil {
+            return err
+        }
+        if min.Compare(max) > 0 {
+            return fmt.Errorf("invalid UUID range: %s is after %s", min, max)
+        }
+        d.AddRange(Range{Min: min, Max: max})
+        return nil
+    }
+    u, err := Parse(entry)
+    if err != nil {
+        return err
+    }
+    d.Add(u)
+    return nil
+}
+
+// denyList holds the deny list consulted by generators and validators
+var denyList atomic.Pointer[DenyList]
+
+// SetDenyList installs d as the process-wide deny list. Generators
+// regenerate UUIDs that are on it, and Profile.Validate and Verify reject
+// them. A nil d disables the check, which is the default.
+func SetDenyList(d *DenyList) {
+    denyList.Store(d)
+}
+
+// ActiveDenyList returns the list installed with SetDenyList, or nil
+func ActiveDenyList() *DenyList {
+    return denyList.Load()
+}
+
+// checkDenied returns an error wrapping ErrDenied if u is on the active
+// deny list
+func checkDenied(u UUID) error {
+    if d := denyList.Load(); d != nil {
+        return d.Check(u)
+    }
+    return nil
+}
+
+// generateAllowed calls gen until it returns a UUID that is not on the
+// active deny list
+func generateAllowed(gen func() (UUID, error)) (UUID, error) {
+    d := denyList.Load()
+    for attempt := 1; ; attempt++ {
+        uuid, err := gen()
+        if err != nil || d == nil || !d.Denies(uuid) {
+            return uuid, err
+        }
+        if attempt == maxDenyAttempts {
+            return Nil, fmt.Errorf("%w: %s still denied after %d attempts", ErrDenied, uuid, attempt)
+        }
+    }
+}
@@ -0,0 +1,128 @@
+package uuid
+
+import (
+    "bytes"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func useDenyList(t *testing.T, d *DenyList) {
+    SetDenyList(d)
+    t.Cleanup(func() { SetDenyList(nil) })
+}
+
+func TestDenyList(t *testing.T) {
+    d := NewDenyList()
+    assert.True(t, d.Denies(Nil))
+    assert.True(t, d.Denies(maxUUID))
+    assert.False(t, d.Denies(NamespaceDNS))
+
+    d.Add(NamespaceDNS)
+    r, err := MatchPrefixHex("0b1e")
+    require.NoError(t, err)
+    d.AddRange(r)
+    assert.True(t, d.Denies(NamespaceDNS))
+    assert.True(t, d.Denies(MustParse("0b1e1234-0000-4000-8000-000000000000")))
+    assert.False(t, d.Denies(MustParse("0b1f1234-0000-4000-8000-000000000000")))
+
+    err = d.Check(NamespaceDNS)
+    assert.ErrorIs(t, err, ErrDenied)
+    assert.NoError(t, d.Check(NamespaceURL))
+}
+
+func TestLoadDenyList(t *testing.T) {
+    d, err := LoadDenyList(strings.NewReader(`
+# reserved
+0b1e0000-*
+6ba7b810-9dad-11d1-80b4-00c04fd430c8
+00000000-0000-0000-0000-000000000001..00000000-0000-0000-0000-0000000000ff
+`))
+    require.NoError(t, err)
+    assert.True(t, d.Denies(Nil))
+    assert.True(t, d.Denies(NamespaceDNS))
+    assert.True(t, d.Denies(MustParse("0b1e0000-1234-4000-8000-000000000000")))
+    assert.False(t, d.Denies(MustParse("0b1e0001-1234-4000-8000-000000000000")))
+    assert.True(t, d.Denies(MustParse("00000000-0000-0000-0000-000000000080")))
+    assert.False(t, d.Denies(MustParse("00000000-0000-0000-0000-000000000100")))
+
+    for _, bad := range []string{
+        "not-a-uuid",
+        "xyz*",
+        "00000000-0000-0000-0000-0000000000ff..00000000-0000-0000-0000-000000000001",
+    } {
+        _, err := LoadDenyList(strings.NewReader(bad))
+        assert.Error(t, err, bad)
+    }
+
+    path := filepath.Join(t.TempDir(), "deny.txt")
+    require.NoError(t, os.WriteFile(path, []byte(NamespaceURL.String()+"\n"), 0o600))
+    d, err = LoadDenyListFile(path)
+    require.NoError(t, err)
+    assert.True(t, d.Denies(NamespaceURL))
+
+    _, err = LoadDenyListFile(filepath.Join(t.TempDir(), "missing"))
+    assert.Error(t, err)
+}
+
+func TestDenyListRegenerates(t *testing.T) {
+    gen, err := NewSeededGenerator(VersionRandom, 1)
+    require.NoError(t, err)
+    first := Must(gen.Generate())
+    second := Must(gen.Generate())
+
+    d := NewDenyList()
+    d.Add(first)
+    useDenyList(t, d)
+
+    gen, err = NewSeededGenerator(VersionRandom, 1)
+    require.NoError(t, err)
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, second, uuid)
+}
+
+func TestDenyListExhausted(t *testing.T) {
+    d := NewDenyList()
+    d.AddRange(Range{Min: Nil, Max: maxUUID})
+    useDenyList(t, d)
+
+    _, err := NewV4()
+    assert.ErrorIs(t, err, ErrDenied)
+    _, err = NewV1()
+    assert.ErrorIs(t, err, ErrDenied)
+    _, err = NewV7()
+    assert.ErrorIs(t, err, ErrDenied)
+    assert.Equal(t, Nil, New())
+
+    // Name-based UUIDs are deterministic, so regenerating cannot help
+    gen := MustNewGenerator(VersionNameBasedSHA1, WithName(NamespaceDNS, "example.com"))
+    _, err = gen.Generate()
+    assert.ErrorIs(t, err, ErrDenied)
+}
+
+func TestDenyListValidators(t *testing.T) {
+    assert.NoError(t, ProfileStrictRFC9562.Validate(Nil))
+
+    d := NewDenyList()
+    d.Add(NamespaceDNS)
+    useDenyList(t, d)
+    assert.Same(t, d, ActiveDenyList())
+
+    assert.ErrorIs(t, ProfileStrictRFC9562.Validate(Nil), ErrDenied)
+    assert.ErrorIs(t, ProfileLenientLegacy.Validate(NamespaceDNS), ErrDenied)
+    assert.NoError(t, ProfileLenientLegacy.Validate(NamespaceURL))
+
+    var buf bytes.Buffer
+    buf.WriteString(`"` + NamespaceURL.String() + `"` + "\n")
+    buf.WriteString(`"` + NamespaceDNS.String() + `"` + "\n")
+    report, err := Verify(&buf, VerifyOptions{Format: ExportNDJSON})
+    require.NoError(t, err)
+    require.Len(t, report.Errors, 1)
+    assert.Equal(t, 2, report.Errors[0].Line)
+    assert.ErrorIs(t, report.Errors[0], ErrDenied)
+}
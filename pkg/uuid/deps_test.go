@@ -0,0 +1,38 @@
+package uuid
+
+import (
+    "go/parser"
+    "go/token"
+    "os"
+    "path/filepath"
+    "strconv"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+// TestStdlibOnly keeps the core package free of third-party dependencies
+func TestStdlibOnly(t *testing.T) {
+    files, err := filepath.Glob("*.go")
+    require.NoError(t, err)
+
+    fset := token.NewFileSet()
+    for _, name := range files {
+        if strings.HasSuffix(name, "_test.go") {
+            continue
+        }
+        src, err := os.ReadFile(name)
+        require.NoError(t, err)
+        f, err := parser.ParseFile(fset, name, src, parser.ImportsOnly)
+        require.NoError(t, err)
+
+        for _, imp := range f.Imports {
+            path, err := strconv.Unquote(imp.Path.Value)
+            require.NoError(t, err)
+            first := strings.SplitN(path, "/", 2)[0]
+            assert.False(t, strings.Contains(first, "."), "%s imports third-party package %s", name, path)
+        }
+    }
+}
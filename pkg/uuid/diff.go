@@ -0,0 +1,76 @@
+package uuid
+
+import (
+    "fmt"
+    "strings"
+    "time"
+)
+
+// DiffString returns an aligned, field-by-field comparison of a and b for
+// test failure messages. Rows that differ are marked with '!' and a caret
+// line points at the differing hex digits.
+func DiffString(a, b UUID) string {
+    var sb strings.Builder
+    row := func(name, x, y string) {
+        mark := " "
+        if x != y {
+            mark = "!"
+        }
+        fmt.Fprintf(&sb, "%s %-8s %-36s  %s\n", mark, name, x, y)
+    }
+
+    as, bs := a.String(), b.String()
+    row("uuid", as, bs)
+    if as != bs {
+        carets := make([]byte, len(as))
+        for i := range carets {
+            carets[i] = ' '
+            if as[i] != bs[i] {
+                carets[i] = '^'
+            }
+        }
+        fmt.Fprintf(&sb, "  %-8s %s\n", "", strings.TrimRight(string(carets), " "))
+    }
+
+    row("version", fmt.Sprint(a.Version()), fmt.Sprint(b.Version()))
+    row("variant", variantName(a.Variant()), variantName(b.Variant()))
+    row("time", diffTime(a), diffTime(b))
+    row("clockseq", diffInt(a.ClockSequence()), diffInt(b.ClockSequence()))
+    row("node", diffBytes(a.NodeID()), diffBytes(b.NodeID()))
+    return sb.String()
+}
+
+func variantName(v Variant) string {
+    switch v {
+    case VariantNCS:
+        return "NCS"
+    case VariantRFC4122:
+        return "RFC4122"
+    case VariantMicrosoft:
+        return "Microsoft"
+    default:
+        return "Future"
+    }
+}
+
+func diffTime(u UUID) string {
+    ts, err := u.Time()
+    if err != nil {
+        return "-"
+    }
+    return ts.UTC().Format(time.RFC3339Nano)
+}
+
+func diffInt(n int) string {
+    if n < 0 {
+        return "-"
+    }
+    return fmt.Sprint(n)
+}
+
+func diffBytes(b []byte) string {
+    if b == nil {
+        return "-"
+    }
+    return fmt.Sprintf("%x", b)
+}
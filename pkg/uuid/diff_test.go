@@ -0,0 +1,29 @@
+package uuid
+
+import (
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestDiffString(t *testing.T) {
+    a := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    b := MustParse("550e8400-e29b-71d4-a716-446655440001")
+
+    diff := DiffString(a, b)
+    lines := strings.Split(strings.TrimRight(diff, "\n"), "\n")
+
+    assert.True(t, strings.HasPrefix(lines[0], "! uuid"))
+    assert.Equal(t, strings.Repeat(" ", 11+14)+"^"+strings.Repeat(" ", 20)+"^", lines[1])
+    assert.True(t, strings.HasPrefix(lines[2], "! version"))
+    assert.True(t, strings.HasPrefix(lines[3], "  variant"))
+    assert.True(t, strings.HasPrefix(lines[4], "! time"))
+}
+
+func TestDiffStringEqual(t *testing.T) {
+    a := New()
+    diff := DiffString(a, a)
+    assert.NotContains(t, diff, "!")
+    assert.NotContains(t, diff, "^")
+}
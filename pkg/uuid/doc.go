@@ -0,0 +1,12 @@
+// Package uuid generates, parses and formats UUIDs as defined by RFC 9562.
+//
+// The package depends on the standard library only, so it can be embedded
+// in size-constrained builds such as TinyGo firmware. On targets without a
+// network stack (tinygo, js, wasip1) hardware addresses are not consulted
+// and time-based generators always use a random node ID.
+//
+// Integrations that need third-party packages live in separate modules so
+// that importing this package never pulls in their dependencies: pgxuuid
+// (pgx), gormuuid (GORM), entuuid (ent) and promuuid (Prometheus), all
+// under pkg/.
+package uuid
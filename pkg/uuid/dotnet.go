@@ -0,0 +1,140 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "fmt"
+    "strings"
+)
+
+// dotNetSpecs lists the .NET Guid format specifiers in the order
+// ParseExact tries them when no specifier is given
+const dotNetSpecs = "DNBPX"
+
+// DotNetLayout returns the layout of a .NET Guid.ToString format
+// specifier, for use with Format:
+//
+//    N  00000000000000000000000000000000
+//    D  00000000-0000-0000-0000-000000000000 (also the empty spec)
+//    B  {00000000-0000-0000-0000-000000000000}
+//    P  (00000000-0000-0000-0000-000000000000)
+//    X  {0x00000000,0x0000,0x0000,{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00}}
+//
+// Specifiers are case-insensitive and digits are always lowercase, as in
+// .NET.
+func DotNetLayout(spec string) (Layout, error) {
+    switch strings.ToUpper(spec) {
+    case "", "D":
+        return LayoutCanonical, nil
+    case "N":
+        return LayoutCompact, nil
+    case "B":
+        return LayoutBraced, nil
+    case "P":
+        return Layout{Enclosure: EncloseParens}, nil
+    case "X":
+        return Layout{Grouping: GroupStruct}, nil
+    default:
+        return Layout{}, fmt.Errorf("invalid format specifier: %q", spec)
+    }
+}
+
+// FormatSpec returns u as formatted by .NET's Guid.ToString(spec), see
+// DotNetLayout for the specifiers
+func (u UUID) FormatSpec(spec string) (string, error) {
+    l, err := DotNetLayout(spec)
+    if err != nil {
+        return "", err
+    }
+    return u.Format(l), nil
+}
+
+// ParseExact parses s in the layout of a .NET format specifier (see
+// DotNetLayout), like Guid.ParseExact. Hex digits may be in either case. An
+// empty spec accepts any of the five layouts, like Guid.Parse.
+func ParseExact(s, spec string) (UUID, error) {
+    if spec == "" {
+        for _, c := range dotNetSpecs {
+            if uuid, err := ParseExact(s, string(c)); err == nil {
+                return uuid, nil
+            }
+        }
+        return Nil, fmt.Errorf("%w: %q matches no .NET layout", ErrInvalidFormat, s)
+    }
+
+    switch strings.ToUpper(spec) {
+    case "D":
+        return parseDotNetD(s)
+    case "N":
+        var uuid UUID
+        if len(s) != 2*Size {
+            return Nil, fmt.Errorf("%w: %d", ErrInvalidLength, len(s))
+        }
+        if _, err := hex.Decode(uuid[:], []byte(s)); err != nil {
+            return Nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+        }
+        return uuid, nil
+    case "B":
+        return parseEnclosed(s, '{', '}')
+    case "P":
+        return parseEnclosed(s, '(', ')')
+    case "X":
+        return parseDotNetX(s)
+    default:
+        return Nil, fmt.Errorf("invalid format specifier: %q", spec)
+    }
+}
+
+func parseDotNetD(s string) (UUID, error) {
+    if len(s) != EncodedLen {
+        return Nil, fmt.Errorf("%w: %d", ErrInvalidLength, len(s))
+    }
+    return ParseFixed([EncodedLen]byte([]byte(s)))
+}
+
+func parseEnclosed(s string, open, close byte) (UUID, error) {
+    if len(s) != EncodedLen+2 || s[0] != open || s[len(s)-1] != close {
+        return Nil, fmt.Errorf("%w: expected %c...%c", ErrInvalidFormat, open, close)
+    }
+    return parseDotNetD(s[1 : len(s)-1])
+}
+
+// parseDotNetX parses the X layout, whose fields have fixed widths
+func parseDotNetX(s string) (UUID, error) {
+    var uuid UUID
+    fail := fmt.Errorf("%w: expected {0x........,0x....,0x....,{0x..,...}}", ErrInvalidFormat)
+
+    rest, ok := strings.CutPrefix(s, "{")
+    if !ok {
+        return Nil, fail
+    }
+    off := 0
+    field := func(width int, sep string) bool {
+        var digits string
+        if len(rest) < 2+width || !strings.EqualFold(rest[:2], "0x") {
+            return false
+        }
+        digits, rest = rest[2:2+width], rest[2+width:]
+        if _, err := hex.Decode(uuid[off:off+width/2], []byte(digits)); err != nil {
+            return false
+        }
+        off += width / 2
+        rest, ok = strings.CutPrefix(rest, sep)
+        return ok
+    }
+    if !field(8, ",") || !field(4, ",") || !field(4, ",{") {
+        return Nil, fail
+    }
+    for i := 0; i < 8; i++ {
+        sep := ","
+        if i == 7 {
+            sep = "}}"
+        }
+        if !field(2, sep) {
+            return Nil, fail
+        }
+    }
+    if rest != "" {
+        return Nil, fail
+    }
+    return uuid, nil
+}
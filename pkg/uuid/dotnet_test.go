@@ -0,0 +1,86 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+// Outputs of new Guid("6ba7b810-9dad-11d1-80b4-00c04fd430c8").ToString(spec)
+var dotNetForms = map[string]string{
+    "N": "6ba7b8109dad11d180b400c04fd430c8",
+    "D": "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+    "B": "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+    "P": "(6ba7b810-9dad-11d1-80b4-00c04fd430c8)",
+    "X": "{0x6ba7b810,0x9dad,0x11d1,{0x80,0xb4,0x00,0xc0,0x4f,0xd4,0x30,0xc8}}",
+}
+
+func TestDotNetLayout(t *testing.T) {
+    for spec, want := range dotNetForms {
+        l, err := DotNetLayout(spec)
+        require.NoError(t, err)
+        assert.Equal(t, want, NamespaceDNS.Format(l), spec)
+
+        lower, err := DotNetLayout(string(spec[0] + 'a' - 'A'))
+        require.NoError(t, err)
+        assert.Equal(t, l, lower, spec)
+    }
+
+    l, err := DotNetLayout("")
+    require.NoError(t, err)
+    assert.Equal(t, NamespaceDNS.String(), NamespaceDNS.Format(l))
+
+    _, err = DotNetLayout("Q")
+    assert.Error(t, err)
+}
+
+func TestFormatSpec(t *testing.T) {
+    for spec, want := range dotNetForms {
+        got, err := NamespaceDNS.FormatSpec(spec)
+        require.NoError(t, err)
+        assert.Equal(t, want, got, spec)
+    }
+
+    _, err := NamespaceDNS.FormatSpec("Q")
+    assert.Error(t, err)
+}
+
+func TestParseExact(t *testing.T) {
+    for spec, s := range dotNetForms {
+        u, err := ParseExact(s, spec)
+        require.NoError(t, err, spec)
+        assert.Equal(t, NamespaceDNS, u, spec)
+
+        // Guid.Parse accepts every layout, in either case
+        l, err := DotNetLayout(spec)
+        require.NoError(t, err)
+        l.Upper = true
+        u, err = ParseExact(NamespaceDNS.Format(l), "")
+        require.NoError(t, err, spec)
+        assert.Equal(t, NamespaceDNS, u, spec)
+
+        // Each layout rejects the others
+        for other, o := range dotNetForms {
+            if other != spec {
+                _, err := ParseExact(o, spec)
+                assert.Error(t, err, "%s as %s", other, spec)
+            }
+        }
+    }
+
+    for _, bad := range []string{
+        "",
+        "{0x6ba7b810,0x9dad,0x11d1,{0x80,0xb4,0x00,0xc0,0x4f,0xd4,0x30,0xc8}",
+        "{0x6ba7b810,0x9dad,0x11d1,{0x80,0xb4,0x00,0xc0,0x4f,0xd4,0x30,0xc8}}x",
+        "{0x6ba7b810,0x9dad,0x11d1,{0x80,0xb4,0x00,0xc0,0x4f,0xd4,0x30,0xzz}}",
+        "{0x6ba7b81,0x9dad,0x11d1,{0x80,0xb4,0x00,0xc0,0x4f,0xd4,0x30,0xc8}}",
+        "(6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+    } {
+        _, err := ParseExact(bad, "")
+        assert.Error(t, err, bad)
+    }
+    _, err := ParseExact(dotNetForms["D"], "Q")
+    assert.Error(t, err)
+}
+
@@ -0,0 +1,101 @@
+package uuid
+
+import "encoding/hex"
+
+const (
+    // Size is the length in bytes of a binary UUID
+    Size = 16
+    // EncodedLen is the length of the canonical 8-4-4-4-12 text form
+    EncodedLen = 36
+)
+
+// AppendCanonical writes the canonical lowercase 8-4-4-4-12 form of u into
+// dst without allocating
+func (u UUID) AppendCanonical(dst *[EncodedLen]byte) {
+    encodeCanonical(dst[:], u)
+}
+
+// ParseFixed parses a canonical 8-4-4-4-12 UUID from a fixed-size buffer
+// without allocating
+func ParseFixed(src [EncodedLen]byte) (UUID, error) {
+    return decodeCanonical(src[:], 0)
+}
+
+// Encode writes the canonical form of u into dst and returns the number of
+// bytes written, EncodedLen. It panics if dst is shorter than EncodedLen,
+// like hex.Encode.
+func (u UUID) Encode(dst []byte) int {
+    _ = dst[EncodedLen-1]
+    encodeCanonical(dst, u)
+    return EncodedLen
+}
+
+// AppendString appends the canonical form of u to dst and returns the
+// extended buffer, for building log lines and wire frames without an
+// intermediate string
+func (u UUID) AppendString(dst []byte) []byte {
+    n := len(dst)
+    dst = append(dst, make([]byte, EncodedLen)...)
+    encodeCanonical(dst[n:], u)
+    return dst
+}
+
+// AppendURN appends the URN form of u to dst and returns the extended
+// buffer
+func (u UUID) AppendURN(dst []byte) []byte {
+    return u.AppendString(append(dst, "urn:uuid:"...))
+}
+
+// AppendCompact appends the 32 hex digits of u, without hyphens, to dst and
+// returns the extended buffer
+func (u UUID) AppendCompact(dst []byte) []byte {
+    return hex.AppendEncode(dst, u[:])
+}
+
+// DecodeString sets u to the UUID in src, in any text form ParseText
+// accepts, without allocating. On error u is unchanged.
+func (u *UUID) DecodeString(src []byte) error {
+    uuid, err := ParseText(src)
+    if err != nil {
+        return err
+    }
+    *u = uuid
+    return nil
+}
+
+// hexDigits maps a nibble to its lowercase hex digit
+const hexDigits = "0123456789abcdef"
+
+// hexPairs maps a byte to its two lowercase hex digits
+var hexPairs = func() (t [256][2]byte) {
+    for i := range t {
+        t[i] = [2]byte{hexDigits[i>>4], hexDigits[i&0x0f]}
+    }
+    return t
+}()
+
+// encodeCanonical writes the canonical form of u into dst, which must hold
+// at least EncodedLen bytes
+func encodeCanonical(dst []byte, u UUID) {
+    _ = dst[EncodedLen-1]
+    copy(dst[0:2], hexPairs[u[0]][:])
+    copy(dst[2:4], hexPairs[u[1]][:])
+    copy(dst[4:6], hexPairs[u[2]][:])
+    copy(dst[6:8], hexPairs[u[3]][:])
+    dst[8] = '-'
+    copy(dst[9:11], hexPairs[u[4]][:])
+    copy(dst[11:13], hexPairs[u[5]][:])
+    dst[13] = '-'
+    copy(dst[14:16], hexPairs[u[6]][:])
+    copy(dst[16:18], hexPairs[u[7]][:])
+    dst[18] = '-'
+    copy(dst[19:21], hexPairs[u[8]][:])
+    copy(dst[21:23], hexPairs[u[9]][:])
+    dst[23] = '-'
+    copy(dst[24:26], hexPairs[u[10]][:])
+    copy(dst[26:28], hexPairs[u[11]][:])
+    copy(dst[28:30], hexPairs[u[12]][:])
+    copy(dst[30:32], hexPairs[u[13]][:])
+    copy(dst[32:34], hexPairs[u[14]][:])
+    copy(dst[34:36], hexPairs[u[15]][:])
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestAppendCanonical(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    var buf [EncodedLen]byte
+    uuid.AppendCanonical(&buf)
+    assert.Equal(t, uuid.String(), string(buf[:]))
+}
+
+func TestParseFixed(t *testing.T) {
+    var buf [EncodedLen]byte
+    copy(buf[:], "550E8400-e29b-41d4-a716-446655440000")
+    uuid, err := ParseFixed(buf)
+    require.NoError(t, err)
+    assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", uuid.String())
+
+    copy(buf[:], "550e8400e-29b-41d4-a716-446655440000")
+    _, err = ParseFixed(buf)
+    assert.Error(t, err)
+
+    copy(buf[:], "550e8400-e29b-41d4-a716-44665544000g")
+    _, err = ParseFixed(buf)
+    assert.Error(t, err)
+}
+
+func TestParseFixedAllocs(t *testing.T) {
+    uuid := New()
+    var buf [EncodedLen]byte
+    allocs := testing.AllocsPerRun(100, func() {
+        uuid.AppendCanonical(&buf)
+        uuid, _ = ParseFixed(buf)
+    })
+    assert.Zero(t, allocs)
+}
+
+func TestEncodeDecodeString(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    buf := make([]byte, EncodedLen+4)
+    n := uuid.Encode(buf)
+    assert.Equal(t, EncodedLen, n)
+    assert.Equal(t, uuid.String(), string(buf[:n]))
+    assert.Panics(t, func() { uuid.Encode(buf[:EncodedLen-1]) })
+
+    var decoded UUID
+    require.NoError(t, decoded.DecodeString(buf[:n]))
+    assert.Equal(t, uuid, decoded)
+
+    assert.Error(t, decoded.DecodeString(buf))
+    assert.Equal(t, uuid, decoded)
+}
+
+func TestEncodeDecodeStringAllocs(t *testing.T) {
+    uuid := New()
+    buf := make([]byte, EncodedLen)
+    var decoded UUID
+    allocs := testing.AllocsPerRun(100, func() {
+        uuid.Encode(buf)
+        if err := decoded.DecodeString(buf); err != nil {
+            t.Fatal(err)
+        }
+    })
+    assert.Zero(t, allocs)
+    assert.Equal(t, uuid, decoded)
+}
+
+func TestAppendString(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    assert.Equal(t, "id=550e8400-e29b-41d4-a716-446655440000", string(uuid.AppendString([]byte("id="))))
+    assert.Equal(t, "id=urn:uuid:550e8400-e29b-41d4-a716-446655440000", string(uuid.AppendURN([]byte("id="))))
+    assert.Equal(t, "id=550e8400e29b41d4a716446655440000", string(uuid.AppendCompact([]byte("id="))))
+    assert.Equal(t, uuid.URN(), string(uuid.AppendURN(nil)))
+
+    buf := make([]byte, 0, 128)
+    allocs := testing.AllocsPerRun(100, func() {
+        buf = uuid.AppendString(buf[:0])
+        buf = uuid.AppendURN(buf)
+        buf = uuid.AppendCompact(buf)
+    })
+    assert.Zero(t, allocs)
+}
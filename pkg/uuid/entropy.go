@@ -0,0 +1,25 @@
+package uuid
+
+import "encoding/binary"
+
+// Uint32Source adapts a hardware random number generator that yields 32
+// bits per call, such as TinyGo's machine.GetRNG, to an io.Reader usable
+// with SetRand or WithRand:
+//
+//    uuid.SetRand(uuid.Uint32Source(machine.GetRNG))
+type Uint32Source func() (uint32, error)
+
+// Read fills p with random bytes from the source
+func (f Uint32Source) Read(p []byte) (int, error) {
+    var buf [4]byte
+    n := 0
+    for n < len(p) {
+        v, err := f()
+        if err != nil {
+            return n, err
+        }
+        binary.LittleEndian.PutUint32(buf[:], v)
+        n += copy(p[n:], buf[:])
+    }
+    return n, nil
+}
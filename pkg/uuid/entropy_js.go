@@ -0,0 +1,38 @@
+//go:build js && wasm
+
+package uuid
+
+import (
+    "errors"
+    "syscall/js"
+)
+
+// maxGetRandomValues is the most bytes crypto.getRandomValues fills per call
+const maxGetRandomValues = 65536
+
+func init() {
+    defaultRander = webCryptoReader{}
+}
+
+// webCryptoReader reads randomness straight from the host's Web Crypto
+// crypto.getRandomValues, which is available in browsers, Node.js and Deno
+type webCryptoReader struct{}
+
+func (webCryptoReader) Read(p []byte) (int, error) {
+    crypto := js.Global().Get("crypto")
+    if crypto.IsUndefined() {
+        return 0, errors.New("crypto.getRandomValues unavailable")
+    }
+
+    n := 0
+    for n < len(p) {
+        size := len(p) - n
+        if size > maxGetRandomValues {
+            size = maxGetRandomValues
+        }
+        buf := js.Global().Get("Uint8Array").New(size)
+        crypto.Call("getRandomValues", buf)
+        n += js.CopyBytesToGo(p[n:n+size], buf)
+    }
+    return n, nil
+}
@@ -0,0 +1,29 @@
+package uuid
+
+import (
+    "errors"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestUint32Source(t *testing.T) {
+    var next uint32
+    src := Uint32Source(func() (uint32, error) {
+        next++
+        return next, nil
+    })
+
+    uuid, err := MustNewGenerator(VersionRandom, WithRand(src)).Generate()
+    require.NoError(t, err)
+    assert.Equal(t, "01000000-0200-4000-8300-000004000000", uuid.String())
+}
+
+func TestUint32SourceError(t *testing.T) {
+    src := Uint32Source(func() (uint32, error) {
+        return 0, errors.New("rng not ready")
+    })
+    _, err := MustNewGenerator(VersionRandom, WithRand(src)).Generate()
+    assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package uuid
+
+import "fmt"
+
+// EnvelopeType is the leading byte of an envelope, identifying the kind of
+// ID in its payload. New ID kinds, possibly with longer payloads, get new
+// types so they can share a column or wire field with UUIDs.
+type EnvelopeType byte
+
+const (
+    // EnvelopeUUID marks a 16-byte UUID payload
+    EnvelopeUUID EnvelopeType = 0x01
+)
+
+// EnvelopeLen is the length of an envelope holding a UUID
+const EnvelopeLen = 1 + Size
+
+// Envelope returns u wrapped in a self-describing 17-byte envelope
+func (u UUID) Envelope() []byte {
+    b := make([]byte, EnvelopeLen)
+    b[0] = byte(EnvelopeUUID)
+    copy(b[1:], u[:])
+    return b
+}
+
+// EnvelopeTypeOf returns the type of an envelope without decoding it
+func EnvelopeTypeOf(b []byte) (EnvelopeType, error) {
+    if len(b) == 0 {
+        return 0, fmt.Errorf("empty envelope")
+    }
+    return EnvelopeType(b[0]), nil
+}
+
+// ParseEnvelope decodes a UUID from an envelope made by Envelope
+func ParseEnvelope(b []byte) (UUID, error) {
+    t, err := EnvelopeTypeOf(b)
+    if err != nil {
+        return Nil, err
+    }
+    if t != EnvelopeUUID {
+        return Nil, fmt.Errorf("envelope type 0x%02x is not a UUID", byte(t))
+    }
+    if len(b) != EnvelopeLen {
+        return Nil, fmt.Errorf("%w: envelope of %d bytes", ErrInvalidLength, len(b))
+    }
+    var uuid UUID
+    copy(uuid[:], b[1:])
+    return uuid, nil
+}
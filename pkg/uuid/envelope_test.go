@@ -0,0 +1,36 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+    uuid := New()
+    env := uuid.Envelope()
+    require.Len(t, env, EnvelopeLen)
+    assert.Equal(t, byte(EnvelopeUUID), env[0])
+
+    typ, err := EnvelopeTypeOf(env)
+    require.NoError(t, err)
+    assert.Equal(t, EnvelopeUUID, typ)
+
+    parsed, err := ParseEnvelope(env)
+    require.NoError(t, err)
+    assert.Equal(t, uuid, parsed)
+}
+
+func TestParseEnvelopeInvalid(t *testing.T) {
+    _, err := ParseEnvelope(nil)
+    assert.Error(t, err)
+
+    env := New().Envelope()
+    _, err = ParseEnvelope(env[:10])
+    assert.Error(t, err)
+
+    env[0] = 0x02
+    _, err = ParseEnvelope(env)
+    assert.Error(t, err)
+}
@@ -0,0 +1,37 @@
+package uuid
+
+import (
+    "errors"
+    "fmt"
+)
+
+// Errors returned, possibly wrapped, when decoding or validating a UUID.
+// Use errors.Is to branch on them.
+var (
+    // ErrInvalidLength is input of the wrong length
+    ErrInvalidLength = errors.New("invalid UUID length")
+    // ErrInvalidFormat is input of the right length that is not a UUID
+    ErrInvalidFormat = errors.New("invalid UUID format")
+    // ErrInvalidVersion is a well-formed UUID of a version the caller
+    // does not accept
+    ErrInvalidVersion = errors.New("invalid UUID version")
+    // ErrInvalidVariant is a well-formed UUID of a variant the caller does
+    // not accept
+    ErrInvalidVariant = errors.New("invalid UUID variant")
+)
+
+// SyntaxError reports the position of the first invalid character in the
+// text form of a UUID. It wraps ErrInvalidFormat.
+type SyntaxError struct {
+    // Offset is the byte offset of the invalid character in the input
+    Offset int
+    Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+    return fmt.Sprintf("%v: %s at offset %d", ErrInvalidFormat, e.Msg, e.Offset)
+}
+
+func (e *SyntaxError) Unwrap() error {
+    return ErrInvalidFormat
+}
@@ -0,0 +1,61 @@
+package uuid
+
+import (
+    "errors"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestErrorKinds(t *testing.T) {
+    strict := func(s string) error { _, err := ParseStrict(s); return err }
+    parse := func(s string) error { _, err := Parse(s); return err }
+
+    tests := []struct {
+        name string
+        err  error
+        want error
+    }{
+        {"Parse length", parse("550e8400"), ErrInvalidLength},
+        {"Parse format", parse("550e8400-e29b-41d4-a716-44665544000g"), ErrInvalidFormat},
+        {"ParseStrict length", strict("550e8400e29b41d4a716446655440000"), ErrInvalidLength},
+        {"ParseStrict hyphen", strict("550e8400_e29b-41d4-a716-446655440000"), ErrInvalidFormat},
+        {"ParseBytes", func() error { _, err := ParseBytes([]byte{1}); return err }(), ErrInvalidLength},
+        {"Validate variant", Validate("00112233-4455-6677-c899-aabbccddeeff"), ErrInvalidVariant},
+        {"ParseExact", func() error { _, err := ParseExact("nope", "B"); return err }(), ErrInvalidFormat},
+        {"FromBase58", func() error { _, err := FromBase58("0OIl0OIl0OIl0OIl0OIl0O"); return err }(), ErrInvalidFormat},
+        {"Profile variant", ProfileStrictRFC9562.Validate(MustParse("00000000-0000-0000-0000-000000000001")), ErrInvalidVariant},
+        {"Time", func() error { _, err := New().Time(); return err }(), ErrInvalidVersion},
+        {"ParsePrefixedID", func() error { _, err := ParsePrefixedID("user_0OIl"); return err }(), ErrInvalidFormat},
+        {"ParseTypeID length", func() error { _, err := ParseTypeID("user_01h455vb4pex5vsknk084sn02"); return err }(), ErrInvalidLength},
+        {"ParseTypeID format", func() error { _, err := ParseTypeID("user_01h455vb4pex5vsknk084sn0uq"); return err }(), ErrInvalidFormat},
+        {"ToXID version", func() error { _, err := New().ToXID(); return err }(), ErrInvalidVersion},
+        {"ToXID padding", func() error { _, err := NewV8([16]byte{15: 1}).ToXID(); return err }(), ErrInvalidFormat},
+    }
+    for _, tt := range tests {
+        require.Error(t, tt.err, tt.name)
+        assert.ErrorIs(t, tt.err, tt.want, tt.name)
+    }
+}
+
+func TestSyntaxError(t *testing.T) {
+    err := Validate("550e8400-e29b-41d4-a716-44665544000g")
+    var syntax *SyntaxError
+    require.True(t, errors.As(err, &syntax))
+    assert.Equal(t, 35, syntax.Offset)
+    assert.ErrorIs(t, err, ErrInvalidFormat)
+
+    _, err = ParseStrict("550e8400-e29b-41d4_a716-446655440000")
+    require.True(t, errors.As(err, &syntax))
+    assert.Equal(t, 18, syntax.Offset)
+    assert.Equal(t, "invalid UUID format: expected hyphen at offset 18", err.Error())
+
+    _, err = ParsePrefixedID("user_2ZGmS0vM8Q3jF6cxbXw4Hk")
+    require.True(t, errors.As(err, &syntax))
+    assert.Equal(t, 5, syntax.Offset)
+
+    _, err = ParseTypeID("user_01h455vb4pex5vsknk084sn0uq")
+    require.True(t, errors.As(err, &syntax))
+    assert.ErrorIs(t, err, ErrInvalidFormat)
+}
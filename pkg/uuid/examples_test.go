@@ -32,7 +32,10 @@ func ExampleParse() {
 
 // ExampleGenerator demonstrates using custom generators
 func ExampleGenerator() {
-    gen := uuid.NewGenerator(uuid.VersionRandom)
+    gen, err := uuid.NewGenerator(uuid.VersionRandom)
+    if err != nil {
+        log.Fatal(err)
+    }
     
     id, err := gen.Generate()
     if err != nil {
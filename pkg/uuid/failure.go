@@ -0,0 +1,75 @@
+package uuid
+
+import (
+    "hash/fnv"
+    "sync/atomic"
+)
+
+// FailureKind classifies why decoding a UUID failed
+type FailureKind int
+
+const (
+    // FailureLength is input of the wrong length
+    FailureLength FailureKind = iota
+    // FailureFormat is input of the right length with invalid characters
+    FailureFormat
+    // FailureType is a database value of a type Scan cannot handle
+    FailureType
+    // FailureSyntax is JSON input that is not a string
+    FailureSyntax
+)
+
+func (k FailureKind) String() string {
+    switch k {
+    case FailureLength:
+        return "length"
+    case FailureFormat:
+        return "format"
+    case FailureType:
+        return "type"
+    case FailureSyntax:
+        return "syntax"
+    default:
+        return "unknown"
+    }
+}
+
+// FailureHook observes failed decodes. inputHash is the 64-bit FNV-1a hash
+// of at most the first 64 bytes of the input, or of the Go type name for
+// FailureType, so malformed IDs can be grouped by source without recording
+// them.
+type FailureHook func(inputHash uint64, kind FailureKind)
+
+// maxFailureSample bounds the input hashed for a FailureHook
+const maxFailureSample = 64
+
+// failureHook holds the hook installed by SetFailureHook
+var failureHook atomic.Value
+
+// failureHookHolder gives atomic.Value a single concrete type to store
+type failureHookHolder struct {
+    hook FailureHook
+}
+
+// SetFailureHook installs a hook called whenever Parse, Scan or
+// UnmarshalJSON fails, for telemetry on malformed IDs received at the
+// edge. The hook runs synchronously on the failing call, so it must be
+// fast and safe for concurrent use. A nil hook disables reporting.
+// SetFailureHook is safe for concurrent use.
+func SetFailureHook(hook FailureHook) {
+    failureHook.Store(failureHookHolder{hook: hook})
+}
+
+// reportFailure calls the failure hook, if any, for input
+func reportFailure(input string, kind FailureKind) {
+    h, _ := failureHook.Load().(failureHookHolder)
+    if h.hook == nil {
+        return
+    }
+    if len(input) > maxFailureSample {
+        input = input[:maxFailureSample]
+    }
+    sum := fnv.New64a()
+    sum.Write([]byte(input))
+    h.hook(sum.Sum64(), kind)
+}
@@ -0,0 +1,67 @@
+package uuid
+
+import (
+    "hash/fnv"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+type failure struct {
+    hash uint64
+    kind FailureKind
+}
+
+func recordFailures(t *testing.T) *[]failure {
+    var got []failure
+    SetFailureHook(func(hash uint64, kind FailureKind) {
+        got = append(got, failure{hash, kind})
+    })
+    t.Cleanup(func() { SetFailureHook(nil) })
+    return &got
+}
+
+func fnvHash(s string) uint64 {
+    h := fnv.New64a()
+    h.Write([]byte(s))
+    return h.Sum64()
+}
+
+func TestFailureHook(t *testing.T) {
+    got := recordFailures(t)
+
+    _, _ = Parse("abc")
+    _, _ = Parse("zzzzzzzz-zzzz-zzzz-zzzz-zzzzzzzzzzzz")
+    var u UUID
+    _ = u.Scan(42)
+    _ = u.UnmarshalJSON([]byte(`123`))
+    _, _ = Parse(New().String())
+
+    assert.Equal(t, []failure{
+        {fnvHash("abc"), FailureLength},
+        {fnvHash("zzzzzzzz-zzzz-zzzz-zzzz-zzzzzzzzzzzz"), FailureFormat},
+        {fnvHash("int"), FailureType},
+        {fnvHash("123"), FailureSyntax},
+    }, *got)
+}
+
+func TestFailureHookSample(t *testing.T) {
+    got := recordFailures(t)
+    long := strings.Repeat("x", 1000)
+    _, _ = Parse(long)
+    assert.Equal(t, []failure{{fnvHash(long[:maxFailureSample]), FailureLength}}, *got)
+}
+
+func TestFailureHookDisabled(t *testing.T) {
+    got := recordFailures(t)
+    SetFailureHook(nil)
+    _, _ = Parse("abc")
+    assert.Empty(t, *got)
+}
+
+func TestFailureKindString(t *testing.T) {
+    assert.Equal(t, "length", FailureLength.String())
+    assert.Equal(t, "syntax", FailureSyntax.String())
+    assert.Equal(t, "unknown", FailureKind(99).String())
+}
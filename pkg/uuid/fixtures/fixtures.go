@@ -0,0 +1,104 @@
+// Package fixtures embeds versioned sets of known-good and known-bad UUID
+// strings, so the tests and fuzzers of downstream projects share one
+// corpus maintained alongside the parser. Published sets never change;
+// corrections and additions go into a new version.
+package fixtures
+
+import (
+    "embed"
+    "encoding/json"
+    "fmt"
+    "io/fs"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// Latest is the newest fixture set
+const Latest = "v1"
+
+//go:embed corpus/*.json
+var files embed.FS
+
+// Valid is an input that must parse to UUID
+type Valid struct {
+    Input string `json:"input"`
+    // UUID is the canonical form of the parsed input
+    UUID string `json:"uuid"`
+    Note string `json:"note"`
+}
+
+// Invalid is an input that must be rejected
+type Invalid struct {
+    Input string `json:"input"`
+    Note  string `json:"note"`
+}
+
+// Set is one version of the fixtures
+type Set struct {
+    Version string    `json:"version"`
+    Valid   []Valid   `json:"valid"`
+    Invalid []Invalid `json:"invalid"`
+}
+
+// Versions returns the available fixture set versions, oldest first
+func Versions() []string {
+    entries, _ := fs.ReadDir(files, "corpus")
+    var versions []string
+    for _, e := range entries {
+        versions = append(versions, strings.TrimSuffix(e.Name(), ".json"))
+    }
+    sortVersions(versions)
+    return versions
+}
+
+// sortVersions sorts set versions by number, so "v10" comes after "v9"
+func sortVersions(versions []string) {
+    sort.Slice(versions, func(i, j int) bool {
+        return versionNumber(versions[i]) < versionNumber(versions[j])
+    })
+}
+
+// versionNumber returns the number of a "vN" version, or -1
+func versionNumber(version string) int {
+    n, err := strconv.Atoi(strings.TrimPrefix(version, "v"))
+    if err != nil || !strings.HasPrefix(version, "v") {
+        return -1
+    }
+    return n
+}
+
+// Load returns the fixture set with the given version
+func Load(version string) (Set, error) {
+    var set Set
+    data, err := files.ReadFile("corpus/" + version + ".json")
+    if err != nil {
+        return set, fmt.Errorf("unknown fixture set: %q", version)
+    }
+    if err := json.Unmarshal(data, &set); err != nil {
+        return set, fmt.Errorf("corrupt fixture set %q: %v", version, err)
+    }
+    return set, nil
+}
+
+// MustLoad is like Load but panics if error occurs
+func MustLoad(version string) Set {
+    set, err := Load(version)
+    if err != nil {
+        panic(err)
+    }
+    return set
+}
+
+// Inputs returns every valid and invalid input of the set, e.g. to seed a
+// fuzz corpus with f.Add
+func (s Set) Inputs() []string {
+    inputs := make([]string, 0, len(s.Valid)+len(s.Invalid))
+    for _, v := range s.Valid {
+        inputs = append(inputs, v.Input)
+    }
+    for _, v := range s.Invalid {
+        inputs = append(inputs, v.Input)
+    }
+    return inputs
+}
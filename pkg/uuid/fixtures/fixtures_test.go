@@ -0,0 +1,66 @@
+package fixtures
+
+import (
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestVersions(t *testing.T) {
+    versions := Versions()
+    require.NotEmpty(t, versions)
+    assert.Equal(t, Latest, versions[len(versions)-1])
+
+    versions = []string{"v10", "v2", "v1", "v9"}
+    sortVersions(versions)
+    assert.Equal(t, []string{"v1", "v2", "v9", "v10"}, versions)
+}
+
+func TestFixturesAgainstParse(t *testing.T) {
+    for _, version := range Versions() {
+        set, err := Load(version)
+        require.NoError(t, err)
+        assert.Equal(t, version, set.Version)
+        require.NotEmpty(t, set.Valid)
+        require.NotEmpty(t, set.Invalid)
+
+        for _, v := range set.Valid {
+            u, err := uuid.Parse(v.Input)
+            if assert.NoError(t, err, "%s: %s", version, v.Note) {
+                assert.Equal(t, v.UUID, u.String(), "%s: %s", version, v.Note)
+            }
+        }
+        for _, v := range set.Invalid {
+            _, err := uuid.Parse(v.Input)
+            assert.Error(t, err, "%s: %s", version, v.Note)
+        }
+    }
+}
+
+func TestLoadUnknown(t *testing.T) {
+    _, err := Load("v0")
+    assert.Error(t, err)
+    assert.Panics(t, func() { MustLoad("../fixtures") })
+}
+
+func TestInputs(t *testing.T) {
+    set := MustLoad(Latest)
+    assert.Len(t, set.Inputs(), len(set.Valid)+len(set.Invalid))
+}
+
+func FuzzParse(f *testing.F) {
+    for _, s := range MustLoad(Latest).Inputs() {
+        f.Add(s)
+    }
+    f.Fuzz(func(t *testing.T, s string) {
+        u, err := uuid.Parse(s)
+        if err != nil {
+            return
+        }
+        back, err := uuid.Parse(u.String())
+        require.NoError(t, err)
+        assert.Equal(t, u, back)
+    })
+}
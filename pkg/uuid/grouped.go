@@ -0,0 +1,45 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "fmt"
+    "strings"
+)
+
+// GroupedString returns the 32 hex digits of u split into groups of
+// groupSize separated by sep, e.g. "550e 8400 e29b ..." for display in
+// UIs. A groupSize of 0 or less yields the ungrouped digits.
+func (u UUID) GroupedString(sep rune, groupSize int) string {
+    var digits [32]byte
+    hex.Encode(digits[:], u[:])
+    if groupSize <= 0 || groupSize >= len(digits) {
+        return string(digits[:])
+    }
+
+    var sb strings.Builder
+    sb.Grow(len(digits) + (len(digits)-1)/groupSize*4)
+    for i := 0; i < len(digits); i += groupSize {
+        if i > 0 {
+            sb.WriteRune(sep)
+        }
+        end := i + groupSize
+        if end > len(digits) {
+            end = len(digits)
+        }
+        sb.Write(digits[i:end])
+    }
+    return sb.String()
+}
+
+// ParseGrouped parses the output of GroupedString, ignoring every sep
+func ParseGrouped(s string, sep rune) (UUID, error) {
+    var uuid UUID
+    digits := strings.ReplaceAll(s, string(sep), "")
+    if len(digits) != 32 {
+        return uuid, fmt.Errorf("%w: %d", ErrInvalidLength, len(digits))
+    }
+    if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+        return Nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+    }
+    return uuid, nil
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestGroupedString(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    assert.Equal(t, "550e-8400-e29b-41d4-a716-4466-5544-0000", uuid.GroupedString('-', 4))
+    assert.Equal(t, "550e84 00e29b 41d4a7 164466 554400 00", uuid.GroupedString(' ', 6))
+    assert.Equal(t, "550e8400·e29b41d4·a7164466·55440000", uuid.GroupedString('·', 8))
+    assert.Equal(t, "550e8400e29b41d4a716446655440000", uuid.GroupedString('-', 0))
+}
+
+func TestParseGrouped(t *testing.T) {
+    uuid := New()
+    for _, sep := range []rune{'-', ' ', '·', '_'} {
+        for _, size := range []int{2, 4, 5, 8} {
+            parsed, err := ParseGrouped(uuid.GroupedString(sep, size), sep)
+            require.NoError(t, err)
+            assert.Equal(t, uuid, parsed)
+        }
+    }
+
+    _, err := ParseGrouped("550e 8400", ' ')
+    assert.Error(t, err)
+    _, err = ParseGrouped("550e-8400-e29b-41d4-a716-4466-5544-000g", '-')
+    assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package uuid
+
+import (
+    "database/sql/driver"
+    "fmt"
+)
+
+// ToGUIDBytes returns u in the mixed-endian layout of the Windows GUID
+// structure, as stored by SQL Server uniqueidentifier columns and returned
+// by .NET's Guid.ToByteArray: the first three groups are little-endian
+// and the last eight bytes are unchanged
+func (u UUID) ToGUIDBytes() []byte {
+    b := make([]byte, Size)
+    swapGUIDBytes(b, u[:])
+    return b
+}
+
+// FromGUIDBytes decodes 16 bytes in the mixed-endian GUID layout, the
+// inverse of ToGUIDBytes
+func FromGUIDBytes(b []byte) (UUID, error) {
+    var uuid UUID
+    if len(b) != Size {
+        return uuid, fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(b))
+    }
+    swapGUIDBytes(uuid[:], b)
+    return uuid, nil
+}
+
+// swapGUIDBytes converts between the big-endian and mixed-endian layouts;
+// the conversion is its own inverse
+func swapGUIDBytes(dst, src []byte) {
+    dst[0], dst[1], dst[2], dst[3] = src[3], src[2], src[1], src[0]
+    dst[4], dst[5] = src[5], src[4]
+    dst[6], dst[7] = src[7], src[6]
+    copy(dst[8:], src[8:16])
+}
+
+// SQLServerUUID is a UUID stored in a SQL Server uniqueidentifier column.
+// Drivers exchange uniqueidentifier values as 16 bytes in the mixed-endian
+// GUID layout, which SQLServerUUID converts to and from so IDs round-trip
+// unchanged. Its text and JSON forms are those of UUID.
+type SQLServerUUID UUID
+
+// UUID returns the UUID
+func (s SQLServerUUID) UUID() UUID {
+    return UUID(s)
+}
+
+// String returns the canonical string form
+func (s SQLServerUUID) String() string {
+    return UUID(s).String()
+}
+
+// Value implements driver.Valuer, returning the mixed-endian bytes
+func (s SQLServerUUID) Value() (driver.Value, error) {
+    return UUID(s).ToGUIDBytes(), nil
+}
+
+// Scan implements sql.Scanner. A 16-byte value is read in the mixed-endian
+// layout, unless only its big-endian reading is a well-formed RFC 9562
+// UUID, which is taken as a value written by a client that did not swap
+// the bytes. Strings are parsed as by UUID.Scan.
+func (s *SQLServerUUID) Scan(value interface{}) error {
+    b, ok := value.([]byte)
+    if !ok || len(b) != Size {
+        return (*UUID)(s).Scan(value)
+    }
+
+    mixed, _ := FromGUIDBytes(b)
+    var raw UUID
+    copy(raw[:], b)
+    if !wellFormed(mixed) && wellFormed(raw) {
+        *s = SQLServerUUID(raw)
+    } else {
+        *s = SQLServerUUID(mixed)
+    }
+    return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s SQLServerUUID) MarshalText() ([]byte, error) {
+    return UUID(s).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *SQLServerUUID) UnmarshalText(text []byte) error {
+    return (*UUID)(s).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler
+func (s SQLServerUUID) MarshalJSON() ([]byte, error) {
+    return UUID(s).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *SQLServerUUID) UnmarshalJSON(data []byte) error {
+    return (*UUID)(s).UnmarshalJSON(data)
+}
+
+// wellFormed reports whether u has the RFC variant and a version defined
+// by RFC 9562
+func wellFormed(u UUID) bool {
+    v := u.Version()
+    return u.Variant() == VariantRFC4122 && v >= VersionTimeBased && v <= VersionCustom
+}
@@ -0,0 +1,66 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestGUIDBytes(t *testing.T) {
+    // .NET: new Guid("00112233-4455-6677-8899-aabbccddeeff").ToByteArray()
+    u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+    b := u.ToGUIDBytes()
+    assert.Equal(t, "33221100554477668899aabbccddeeff", hex.EncodeToString(b))
+
+    back, err := FromGUIDBytes(b)
+    require.NoError(t, err)
+    assert.Equal(t, u, back)
+
+    _, err = FromGUIDBytes(b[:8])
+    assert.Error(t, err)
+}
+
+func TestSQLServerUUID(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    v, err := SQLServerUUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.ToGUIDBytes(), v)
+
+    var s SQLServerUUID
+    require.NoError(t, s.Scan(v))
+    assert.Equal(t, u, s.UUID())
+
+    require.NoError(t, s.Scan(u.String()))
+    assert.Equal(t, u, s.UUID())
+
+    data, err := json.Marshal(s)
+    require.NoError(t, err)
+    assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, string(data))
+    var decoded SQLServerUUID
+    require.NoError(t, json.Unmarshal(data, &decoded))
+    assert.Equal(t, s, decoded)
+
+    assert.Error(t, s.Scan(42))
+}
+
+func TestSQLServerUUIDDetectsByteOrder(t *testing.T) {
+    // Bytes written without swapping: their mixed-endian reading has
+    // version nibble 0xd, so the big-endian reading is used
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    mixed, _ := FromGUIDBytes(u[:])
+    require.False(t, wellFormed(mixed))
+
+    var s SQLServerUUID
+    require.NoError(t, s.Scan(u[:]))
+    assert.Equal(t, u, s.UUID())
+
+    // Ambiguous values keep the mixed-endian reading of the column type
+    amb := MustParse("00000000-0000-4444-8000-000000000000")
+    require.NoError(t, s.Scan(amb.ToGUIDBytes()))
+    assert.Equal(t, amb, s.UUID())
+    require.NoError(t, s.Scan(amb[:]))
+    assert.Equal(t, amb, s.UUID())
+}
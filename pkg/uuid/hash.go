@@ -0,0 +1,48 @@
+package uuid
+
+import (
+    "crypto/md5"
+    "crypto/sha1"
+    "hash"
+)
+
+// Well-known namespace UUIDs from RFC 9562 for name-based generation
+var (
+    NamespaceDNS  = MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+    NamespaceURL  = MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+    NamespaceOID  = MustParse("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+    NamespaceX500 = MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+)
+
+// WithName sets the namespace and name hashed by a name-based (V3 or V5)
+// generator. Every UUID it generates is the same.
+func WithName(namespace UUID, name string) Option {
+    return func(g *UUIDGenerator) {
+        g.namespace = namespace
+        g.name = name
+        g.nameSet = true
+    }
+}
+
+// NewV3 generates a name-based UUID (Version 3) from the MD5 hash of
+// namespace and name. Prefer NewV5 unless V3 is needed for compatibility.
+func NewV3(namespace UUID, name string) UUID {
+    return newHashed(md5.New(), namespace, name, 0x30)
+}
+
+// NewV5 generates a name-based UUID (Version 5) from the SHA-1 hash of
+// namespace and name
+func NewV5(namespace UUID, name string) UUID {
+    return newHashed(sha1.New(), namespace, name, 0x50)
+}
+
+func newHashed(h hash.Hash, namespace UUID, name string, version byte) UUID {
+    var uuid UUID
+    h.Write(namespace[:])
+    h.Write([]byte(name))
+    copy(uuid[:], h.Sum(nil))
+
+    uuid[6] = (uuid[6] & 0x0f) | version
+    uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC4122
+    return uuid
+}
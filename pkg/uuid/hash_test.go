@@ -0,0 +1,21 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestNewV5(t *testing.T) {
+    // Test vector from RFC 9562 appendix A.4
+    uuid := NewV5(NamespaceDNS, "www.example.com")
+    assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", uuid.String())
+    assert.Equal(t, VersionNameBasedSHA1, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+}
+
+func TestNewV3(t *testing.T) {
+    uuid := NewV3(NamespaceDNS, "www.example.com")
+    assert.Equal(t, "5df41881-3aed-3515-88a7-2f4a814cf09e", uuid.String())
+    assert.Equal(t, VersionNameBasedMD5, uuid.Version())
+}
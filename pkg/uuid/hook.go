@@ -0,0 +1,99 @@
+package uuid
+
+import (
+    "sync"
+    "sync/atomic"
+)
+
+// hookQueueSize is the number of UUIDs buffered for hooks before new ones
+// are dropped
+const hookQueueSize = 1024
+
+// hookQueue runs post-generation hooks on a background goroutine
+type hookQueue struct {
+    hooks   []func(UUID)
+    ch      chan UUID
+    done    chan struct{}
+    dropped atomic.Uint64
+
+    mu     sync.RWMutex
+    closed bool
+}
+
+// WithHook registers fn to be called with every UUID the generator issues,
+// e.g. to publish IDs to an audit log or a bloom filter. Hooks run in
+// registration order on a background goroutine fed by a buffered queue, so
+// they never slow down Generate; if the queue is full the UUID is dropped
+// and counted in Stats. Call Close to flush the queue and stop the
+// goroutine.
+func WithHook(fn func(UUID)) Option {
+    return func(g *UUIDGenerator) {
+        if g.hooks == nil {
+            g.hooks = &hookQueue{}
+        }
+        g.hooks.hooks = append(g.hooks.hooks, fn)
+    }
+}
+
+func (q *hookQueue) start() {
+    q.ch = make(chan UUID, hookQueueSize)
+    q.done = make(chan struct{})
+    go func() {
+        defer close(q.done)
+        for uuid := range q.ch {
+            for _, fn := range q.hooks {
+                fn(uuid)
+            }
+        }
+    }()
+}
+
+// publish queues uuid for the hooks without blocking
+func (q *hookQueue) publish(uuid UUID) {
+    q.mu.RLock()
+    defer q.mu.RUnlock()
+    if q.closed {
+        q.dropped.Add(1)
+        return
+    }
+    select {
+    case q.ch <- uuid:
+    default:
+        q.dropped.Add(1)
+    }
+}
+
+// close stops accepting UUIDs and waits for queued ones to be processed
+func (q *hookQueue) close() {
+    q.mu.Lock()
+    if !q.closed {
+        q.closed = true
+        close(q.ch)
+    }
+    q.mu.Unlock()
+    <-q.done
+}
+
+// Close flushes pending hook invocations, stops the generator's background
+// goroutine and saves its state to the StateStore, if any. UUIDs generated
+// after Close are not passed to hooks.
+func (g *UUIDGenerator) Close() error {
+    g.mu.Lock()
+    stop := g.stopWatch
+    g.stopWatch = nil
+    g.mu.Unlock()
+    if stop != nil {
+        stop()
+    }
+
+    if g.entropy != nil {
+        g.entropy.close()
+    }
+    if g.hooks != nil {
+        g.hooks.close()
+    }
+    if g.store != nil {
+        return g.store.Save(g.ExportState())
+    }
+    return nil
+}
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+    "sync"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestWithHook(t *testing.T) {
+    var mu sync.Mutex
+    var audit, bloom []UUID
+    gen := MustNewGenerator(VersionUnixTime,
+        WithHook(func(u UUID) {
+            mu.Lock()
+            audit = append(audit, u)
+            mu.Unlock()
+        }),
+        WithHook(func(u UUID) {
+            mu.Lock()
+            bloom = append(bloom, u)
+            mu.Unlock()
+        }),
+    )
+
+    var issued []UUID
+    for i := 0; i < 10; i++ {
+        issued = append(issued, Must(gen.Generate()))
+    }
+    require.NoError(t, gen.Close())
+
+    assert.Equal(t, issued, audit)
+    assert.Equal(t, issued, bloom)
+
+    Must(gen.Generate())
+    assert.Equal(t, uint64(1), gen.Stats().HooksDropped)
+    assert.Len(t, audit, 10)
+}
+
+func TestWithHookDropsWhenFull(t *testing.T) {
+    release := make(chan struct{})
+    gen := MustNewGenerator(VersionRandom, WithHook(func(UUID) { <-release }))
+
+    for i := 0; i < hookQueueSize+10; i++ {
+        Must(gen.Generate())
+    }
+    assert.True(t, gen.Stats().HooksDropped > 0)
+
+    close(release)
+    require.NoError(t, gen.Close())
+}
+
+func TestCloseWithoutHooks(t *testing.T) {
+    assert.NoError(t, MustNewGenerator(VersionRandom).Close())
+}
@@ -0,0 +1,74 @@
+package uuid
+
+import "database/sql/driver"
+
+// ID is a UUID tagged with a phantom entity type, so that IDs of different
+// entities are distinct types and cannot be mixed up:
+//
+//	type User struct{ ... }
+//	type UserID = uuid.ID[User]
+//
+// It has the same text, JSON and SQL forms as UUID.
+type ID[T any] UUID
+
+// NewID generates an ID with the default generator. It returns the nil ID
+// if generation fails.
+func NewID[T any]() ID[T] {
+    return ID[T](New())
+}
+
+// IDFrom tags u with the entity type T
+func IDFrom[T any](u UUID) ID[T] {
+    return ID[T](u)
+}
+
+// ParseID parses s into an ID
+func ParseID[T any](s string) (ID[T], error) {
+    u, err := Parse(s)
+    return ID[T](u), err
+}
+
+// UUID returns the untyped UUID
+func (id ID[T]) UUID() UUID {
+    return UUID(id)
+}
+
+// IsNil returns true if the ID is the nil UUID
+func (id ID[T]) IsNil() bool {
+    return UUID(id) == Nil
+}
+
+// String returns the canonical string form of the ID
+func (id ID[T]) String() string {
+    return UUID(id).String()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (id ID[T]) MarshalText() ([]byte, error) {
+    return UUID(id).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (id *ID[T]) UnmarshalText(text []byte) error {
+    return (*UUID)(id).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler
+func (id ID[T]) MarshalJSON() ([]byte, error) {
+    return UUID(id).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (id *ID[T]) UnmarshalJSON(data []byte) error {
+    return (*UUID)(id).UnmarshalJSON(data)
+}
+
+// Value implements driver.Valuer for database operations
+func (id ID[T]) Value() (driver.Value, error) {
+    return UUID(id).Value()
+}
+
+// Scan implements sql.Scanner for database operations
+func (id *ID[T]) Scan(value interface{}) error {
+    return (*UUID)(id).Scan(value)
+}
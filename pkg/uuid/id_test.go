@@ -0,0 +1,61 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+type testUser struct{}
+type testOrder struct{}
+
+func TestID(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    userID := IDFrom[testUser](u)
+    orderID := IDFrom[testOrder](u)
+    assert.Equal(t, u, userID.UUID())
+    assert.Equal(t, userID.UUID(), orderID.UUID())
+    assert.Equal(t, u.String(), userID.String())
+    assert.False(t, userID.IsNil())
+    assert.True(t, ID[testUser]{}.IsNil())
+    assert.False(t, NewID[testUser]().IsNil())
+
+    parsed, err := ParseID[testUser](u.String())
+    require.NoError(t, err)
+    assert.Equal(t, userID, parsed)
+    _, err = ParseID[testUser]("nope")
+    assert.Error(t, err)
+}
+
+func TestIDJSON(t *testing.T) {
+    type order struct {
+        ID    ID[testOrder] `json:"id"`
+        Buyer ID[testUser]  `json:"buyer"`
+    }
+    in := order{ID: NewID[testOrder](), Buyer: NewID[testUser]()}
+    data, err := json.Marshal(in)
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"id":"`+in.ID.String()+`","buyer":"`+in.Buyer.String()+`"}`, string(data))
+
+    var out order
+    require.NoError(t, json.Unmarshal(data, &out))
+    assert.Equal(t, in, out)
+}
+
+func TestIDTextAndSQL(t *testing.T) {
+    id := NewID[testUser]()
+    text, err := id.MarshalText()
+    require.NoError(t, err)
+    var back ID[testUser]
+    require.NoError(t, back.UnmarshalText(text))
+    assert.Equal(t, id, back)
+
+    v, err := id.Value()
+    require.NoError(t, err)
+    var scanned ID[testUser]
+    require.NoError(t, scanned.Scan(v))
+    assert.Equal(t, id, scanned)
+    assert.Error(t, scanned.Scan(42))
+}
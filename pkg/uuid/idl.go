@@ -0,0 +1,204 @@
+package uuid
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+)
+
+// IDLKind identifies the annotation a GUID was found in by FindIDL
+type IDLKind int
+
+const (
+    // IDLAttribute is an IDL uuid(...) attribute, also used by
+    // __declspec(uuid("...")), or a DECLSPEC_UUID("...") macro
+    IDLAttribute IDLKind = iota + 1
+    // IDLMIDLInterface is a MIDL_INTERFACE("...") macro in a MIDL header
+    IDLMIDLInterface
+    // IDLDefineGUID is a DEFINE_GUID or EXTERN_GUID declaration
+    IDLDefineGUID
+)
+
+var idlKeywords = map[string]IDLKind{
+    "uuid":           IDLAttribute,
+    "DECLSPEC_UUID":  IDLAttribute,
+    "MIDL_INTERFACE": IDLMIDLInterface,
+    "DEFINE_GUID":    IDLDefineGUID,
+    "EXTERN_GUID":    IDLDefineGUID,
+}
+
+func (k IDLKind) String() string {
+    switch k {
+    case IDLAttribute:
+        return "uuid"
+    case IDLMIDLInterface:
+        return "MIDL_INTERFACE"
+    case IDLDefineGUID:
+        return "DEFINE_GUID"
+    default:
+        return fmt.Sprintf("idlkind(%d)", int(k))
+    }
+}
+
+// IDLMatch is a GUID annotation found in IDL or MIDL header text
+type IDLMatch struct {
+    UUID UUID
+    Kind IDLKind
+    // Name is the symbol declared by DEFINE_GUID, empty for other kinds
+    Name string
+    // Start and End are the byte offsets of the annotation, from the
+    // keyword to the closing parenthesis inclusive, so text[Start:End]
+    // can be replaced in place
+    Start, End int
+    // Line and Column are the 1-based position of Start, with the column
+    // counted in bytes
+    Line, Column int
+}
+
+// FindIDL returns every GUID annotation in text, in order: IDL uuid(...)
+// attributes, __declspec(uuid("...")), DECLSPEC_UUID("..."),
+// MIDL_INTERFACE("...") and DEFINE_GUID/EXTERN_GUID declarations.
+// Keywords must stand alone as identifiers, and malformed annotations are
+// skipped rather than reported.
+func FindIDL(text string) []IDLMatch {
+    var matches []IDLMatch
+    line, lineStart := 1, 0
+    for i := 0; i < len(text); {
+        c := text[i]
+        if c == '\n' {
+            line++
+            lineStart = i + 1
+        }
+        if !isIdentByte(c) {
+            i++
+            continue
+        }
+
+        start := i
+        for i < len(text) && isIdentByte(text[i]) {
+            i++
+        }
+        kind, ok := idlKeywords[text[start:i]]
+        if !ok {
+            continue
+        }
+
+        m, end, ok := parseIDLArgs(text, i, kind)
+        if !ok {
+            continue
+        }
+        m.Kind = kind
+        m.Start, m.End = start, end
+        m.Line, m.Column = line, start-lineStart+1
+        matches = append(matches, m)
+
+        // Keep the line count right across multi-line DEFINE_GUIDs
+        for ; i < end; i++ {
+            if text[i] == '\n' {
+                line++
+                lineStart = i + 1
+            }
+        }
+    }
+    return matches
+}
+
+// parseIDLArgs parses the parenthesized arguments following a keyword
+// ending at offset i, returning the offset just past the closing
+// parenthesis
+func parseIDLArgs(text string, i int, kind IDLKind) (IDLMatch, int, bool) {
+    var m IDLMatch
+    i = skipSpace(text, i)
+    if i >= len(text) || text[i] != '(' {
+        return m, 0, false
+    }
+    end := strings.IndexByte(text[i:], ')')
+    if end < 0 {
+        return m, 0, false
+    }
+    args, end := text[i+1:i+end], i+end+1
+
+    if kind == IDLDefineGUID {
+        uuid, name, ok := parseDefineGUID(args)
+        if !ok {
+            return m, 0, false
+        }
+        m.UUID, m.Name = uuid, name
+        return m, end, true
+    }
+
+    args = strings.TrimSpace(args)
+    if len(args) >= 2 && args[0] == '"' && args[len(args)-1] == '"' {
+        args = args[1 : len(args)-1]
+    }
+    if len(args) != EncodedLen {
+        return m, 0, false
+    }
+    uuid, err := ParseFixed([EncodedLen]byte([]byte(args)))
+    if err != nil {
+        return m, 0, false
+    }
+    m.UUID = uuid
+    return m, end, true
+}
+
+// parseDefineGUID parses the arguments of DEFINE_GUID(name, l, w1, w2,
+// b1, ..., b8)
+func parseDefineGUID(args string) (UUID, string, bool) {
+    var uuid UUID
+    fields := strings.Split(args, ",")
+    if len(fields) != 12 {
+        return uuid, "", false
+    }
+    name := strings.TrimSpace(fields[0])
+    if name == "" {
+        return uuid, "", false
+    }
+
+    // Data1 is 32 bits, Data2 and Data3 16 bits and Data4 eight bytes,
+    // written most significant byte first like the text form
+    sizes := [11]int{4, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1}
+    off := 0
+    for n, size := range sizes {
+        s := strings.TrimRight(strings.TrimSpace(fields[n+1]), "uUlL")
+        v, err := strconv.ParseUint(s, 0, size*8)
+        if err != nil {
+            return uuid, "", false
+        }
+        for b := size - 1; b >= 0; b-- {
+            uuid[off] = byte(v >> (8 * b))
+            off++
+        }
+    }
+    return uuid, name, true
+}
+
+// IDLAttribute returns the IDL attribute form, uuid(xxxxxxxx-...)
+func (u UUID) IDLAttribute() string {
+    return "uuid(" + u.String() + ")"
+}
+
+// MIDLInterface returns the MIDL header form, MIDL_INTERFACE("XXXXXXXX-...")
+// with uppercase digits as written by midl.exe
+func (u UUID) MIDLInterface() string {
+    return `MIDL_INTERFACE("` + strings.ToUpper(u.String()) + `")`
+}
+
+// DefineGUID returns a DEFINE_GUID declaration of name with the value of u
+func (u UUID) DefineGUID(name string) string {
+    return fmt.Sprintf("DEFINE_GUID(%s, 0x%02x%02x%02x%02x, 0x%02x%02x, 0x%02x%02x, "+
+        "0x%02x, 0x%02x, 0x%02x, 0x%02x, 0x%02x, 0x%02x, 0x%02x, 0x%02x)",
+        name, u[0], u[1], u[2], u[3], u[4], u[5], u[6], u[7],
+        u[8], u[9], u[10], u[11], u[12], u[13], u[14], u[15])
+}
+
+func isIdentByte(c byte) bool {
+    return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func skipSpace(s string, i int) int {
+    for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\r' || s[i] == '\n') {
+        i++
+    }
+    return i
+}
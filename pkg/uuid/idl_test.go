@@ -0,0 +1,83 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+const idlSource = `[
+    object,
+    uuid(00000000-0000-0000-C000-000000000046),
+    pointer_default(unique)
+]
+interface IUnknown;
+
+MIDL_INTERFACE("550e8400-e29b-41d4-a716-446655440000")
+IFoo : public IUnknown {};
+class __declspec(uuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8")) Foo;
+DEFINE_GUID(IID_IBar,
+    0x6ba7b811, 0x9dad, 0x11d1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8);
+myuuid(00000000-0000-0000-C000-000000000046)
+uuid(not-a-guid)
+`
+
+func TestFindIDL(t *testing.T) {
+    matches := FindIDL(idlSource)
+    require.Len(t, matches, 4)
+
+    assert.Equal(t, IDLAttribute, matches[0].Kind)
+    assert.Equal(t, "00000000-0000-0000-c000-000000000046", matches[0].UUID.String())
+    assert.Equal(t, 3, matches[0].Line)
+    assert.Equal(t, 5, matches[0].Column)
+    assert.Equal(t, "uuid(00000000-0000-0000-C000-000000000046)", idlSource[matches[0].Start:matches[0].End])
+
+    assert.Equal(t, IDLMIDLInterface, matches[1].Kind)
+    assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", matches[1].UUID.String())
+    assert.Equal(t, 8, matches[1].Line)
+    assert.Equal(t, 1, matches[1].Column)
+
+    assert.Equal(t, IDLAttribute, matches[2].Kind)
+    assert.Equal(t, NamespaceDNS, matches[2].UUID)
+    assert.Equal(t, `uuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`, idlSource[matches[2].Start:matches[2].End])
+
+    assert.Equal(t, IDLDefineGUID, matches[3].Kind)
+    assert.Equal(t, "IID_IBar", matches[3].Name)
+    assert.Equal(t, NamespaceURL, matches[3].UUID)
+    assert.Equal(t, 11, matches[3].Line)
+}
+
+func TestFindIDLMalformed(t *testing.T) {
+    for _, text := range []string{
+        "",
+        "uuid",
+        "uuid(",
+        "uuid(550e8400-e29b-41d4-a716-44665544000g)",
+        `MIDL_INTERFACE("550e8400e29b41d4a716446655440000")`,
+        "DEFINE_GUID(IID_X, 0x1, 0x2)",
+        "DEFINE_GUID(IID_X, 0x100000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)",
+        "DEFINE_GUID(, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)",
+    } {
+        assert.Empty(t, FindIDL(text), text)
+    }
+
+    matches := FindIDL("EXTERN_GUID(CLSID_X, 1UL, 2, 3, 4, 5, 6, 7, 8, 9, 10, 0xffL)")
+    require.Len(t, matches, 1)
+    assert.Equal(t, "00000001-0002-0003-0405-060708090aff", matches[0].UUID.String())
+}
+
+func TestEmitIDL(t *testing.T) {
+    u := MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+    assert.Equal(t, "uuid(6ba7b811-9dad-11d1-80b4-00c04fd430c8)", u.IDLAttribute())
+    assert.Equal(t, `MIDL_INTERFACE("6BA7B811-9DAD-11D1-80B4-00C04FD430C8")`, u.MIDLInterface())
+    assert.Equal(t, "DEFINE_GUID(IID_IBar, 0x6ba7b811, 0x9dad, 0x11d1, "+
+        "0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8)", u.DefineGUID("IID_IBar"))
+
+    // Every emitted form is found again
+    text := u.IDLAttribute() + "\n" + u.MIDLInterface() + "\n" + u.DefineGUID("IID_IBar")
+    for _, m := range FindIDL(text) {
+        assert.Equal(t, u, m.UUID)
+    }
+    assert.Len(t, FindIDL(text), 3)
+}
@@ -0,0 +1,36 @@
+package uuid
+
+import (
+    "bufio"
+    "io"
+)
+
+// jsonArrayChunk is the size of the buffer EncodeJSONArray fills before
+// each write
+const jsonArrayChunk = 32 * 1024
+
+// EncodeJSONArray writes ids to w as a JSON array of canonical strings,
+// formatting directly into a fixed-size buffer that is flushed in chunks.
+// Unlike json.Marshal it never holds the whole array in memory, which
+// matters for responses carrying hundreds of thousands of IDs.
+func EncodeJSONArray(w io.Writer, ids []UUID) error {
+    bw := bufio.NewWriterSize(w, jsonArrayChunk)
+    var elem [EncodedLen + 3]byte
+    elem[1] = '"'
+    elem[EncodedLen+2] = '"'
+
+    bw.WriteByte('[')
+    for i, id := range ids {
+        elem[0] = ','
+        encodeCanonical(elem[2:], id)
+        b := elem[:]
+        if i == 0 {
+            b = b[1:]
+        }
+        if _, err := bw.Write(b); err != nil {
+            return err
+        }
+    }
+    bw.WriteByte(']')
+    return bw.Flush()
+}
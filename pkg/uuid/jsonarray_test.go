@@ -0,0 +1,55 @@
+package uuid
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "io"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestEncodeJSONArray(t *testing.T) {
+    for _, n := range []int{0, 1, 2, 5000} {
+        ids := make([]UUID, n)
+        for i := range ids {
+            ids[i] = New()
+        }
+
+        var buf bytes.Buffer
+        require.NoError(t, EncodeJSONArray(&buf, ids))
+
+        want, err := json.Marshal(ids)
+        require.NoError(t, err)
+        if n == 0 {
+            want = []byte("[]")
+        }
+        assert.Equal(t, string(want), buf.String(), "n=%d", n)
+    }
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+    return 0, errors.New("closed")
+}
+
+func TestEncodeJSONArrayWriteError(t *testing.T) {
+    ids := make([]UUID, 2000)
+    assert.Error(t, EncodeJSONArray(errWriter{}, ids))
+    assert.Error(t, EncodeJSONArray(errWriter{}, nil))
+}
+
+func BenchmarkEncodeJSONArray(b *testing.B) {
+    ids := make([]UUID, 100000)
+    for i := range ids {
+        ids[i] = New()
+    }
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _ = EncodeJSONArray(io.Discard, ids)
+    }
+}
@@ -0,0 +1,50 @@
+package uuid
+
+import (
+    "fmt"
+    "time"
+)
+
+// LatencyStage identifies the part of UUID generation a latency was
+// measured for
+type LatencyStage int
+
+const (
+    // StageEntropy is a single read from the randomness source
+    StageEntropy LatencyStage = iota
+    // StageGenerate is a complete Generate call
+    StageGenerate
+)
+
+func (s LatencyStage) String() string {
+    switch s {
+    case StageEntropy:
+        return "entropy"
+    case StageGenerate:
+        return "generate"
+    default:
+        return fmt.Sprintf("LatencyStage(%d)", int(s))
+    }
+}
+
+// LatencyObserver receives generation latencies, e.g. to feed histograms
+// (see the promuuid module). It is called synchronously on the
+// generating goroutine and must be safe for concurrent use.
+type LatencyObserver interface {
+    ObserveLatency(stage LatencyStage, version Version, d time.Duration)
+}
+
+// WithLatencyObserver makes a generator time its entropy reads and
+// Generate calls and report them to o. Latencies are measured with the
+// monotonic system clock regardless of WithClock.
+func WithLatencyObserver(o LatencyObserver) Option {
+    return func(g *UUIDGenerator) {
+        g.latency = o
+    }
+}
+
+// observeSince reports the time elapsed since start to the generator's
+// latency observer
+func (g *UUIDGenerator) observeSince(stage LatencyStage, start time.Time) {
+    g.latency.ObserveLatency(stage, g.version, time.Since(start))
+}
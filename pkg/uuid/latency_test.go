@@ -0,0 +1,74 @@
+package uuid
+
+import (
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+)
+
+type recordedLatency struct {
+    stage   LatencyStage
+    version Version
+    d       time.Duration
+}
+
+type latencyRecorder struct {
+    mu  sync.Mutex
+    obs []recordedLatency
+}
+
+func (r *latencyRecorder) ObserveLatency(stage LatencyStage, version Version, d time.Duration) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.obs = append(r.obs, recordedLatency{stage, version, d})
+}
+
+func (r *latencyRecorder) count(stage LatencyStage) int {
+    n := 0
+    for _, o := range r.obs {
+        if o.stage == stage {
+            n++
+        }
+    }
+    return n
+}
+
+func TestLatencyObserver(t *testing.T) {
+    for _, version := range []Version{VersionRandom, VersionUnixTime} {
+        rec := &latencyRecorder{}
+        gen := MustNewGenerator(version, WithLatencyObserver(rec))
+        for i := 0; i < 3; i++ {
+            Must(gen.Generate())
+        }
+        assert.Equal(t, 3, rec.count(StageGenerate), version)
+        assert.Equal(t, 3, rec.count(StageEntropy), version)
+        for _, o := range rec.obs {
+            assert.Equal(t, version, o.version)
+            assert.GreaterOrEqual(t, o.d, time.Duration(0))
+        }
+    }
+
+    // Name-based versions read no entropy
+    rec := &latencyRecorder{}
+    gen := MustNewGenerator(VersionNameBasedSHA1, WithName(NamespaceDNS, "a"), WithLatencyObserver(rec))
+    Must(gen.Generate())
+    assert.Equal(t, 1, rec.count(StageGenerate))
+    assert.Equal(t, 0, rec.count(StageEntropy))
+}
+
+func TestLatencyObserverPool(t *testing.T) {
+    EnableRandPool()
+    defer DisableRandPool()
+
+    rec := &latencyRecorder{}
+    Must(MustNewGenerator(VersionRandom, WithLatencyObserver(rec)).Generate())
+    assert.Equal(t, 1, rec.count(StageEntropy))
+}
+
+func TestLatencyStageString(t *testing.T) {
+    assert.Equal(t, "entropy", StageEntropy.String())
+    assert.Equal(t, "generate", StageGenerate.String())
+    assert.Equal(t, "LatencyStage(7)", LatencyStage(7).String())
+}
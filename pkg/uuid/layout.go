@@ -0,0 +1,122 @@
+package uuid
+
+import "encoding/hex"
+
+// Grouping selects how the hex digits of a formatted UUID are grouped
+type Grouping int
+
+const (
+    // GroupCanonical is the hyphenated 8-4-4-4-12 form
+    GroupCanonical Grouping = iota
+    // GroupCompact is 32 hex digits without hyphens
+    GroupCompact
+    // GroupStruct is a C struct initializer in the layout of a Windows
+    // GUID, {0x00000000,0x0000,0x0000,{0x00,...}}, which carries its own
+    // braces
+    GroupStruct
+)
+
+// Enclosure selects the delimiters around a formatted UUID
+type Enclosure int
+
+const (
+    // EncloseNone writes no delimiters
+    EncloseNone Enclosure = iota
+    // EncloseBraces wraps the UUID in {}
+    EncloseBraces
+    // EncloseParens wraps the UUID in ()
+    EncloseParens
+)
+
+// Layout describes a text presentation of a UUID for Format. The zero
+// value is the canonical lowercase form; the fields compose freely, so
+// callers do not need a separate method for every variant.
+type Layout struct {
+    Grouping Grouping
+    // Upper writes uppercase hex digits
+    Upper bool
+    // Enclosure is ignored with GroupStruct
+    Enclosure Enclosure
+    // URN prefixes the result with "urn:uuid:", before any enclosure
+    URN bool
+}
+
+// Common layouts
+var (
+    LayoutCanonical = Layout{}
+    LayoutCompact   = Layout{Grouping: GroupCompact}
+    LayoutBraced    = Layout{Enclosure: EncloseBraces}
+    LayoutURN       = Layout{URN: true}
+)
+
+// Format returns u in the given layout
+func (u UUID) Format(l Layout) string {
+    b := make([]byte, 0, 68)
+    if l.URN {
+        b = append(b, "urn:uuid:"...)
+    }
+    start := len(b)
+
+    switch l.Grouping {
+    case GroupStruct:
+        b = appendStruct(b, u)
+    case GroupCompact:
+        b = l.close(u.AppendCompact(l.open(b)))
+    default:
+        b = l.close(u.AppendString(l.open(b)))
+    }
+
+    if l.Upper {
+        upperHex(b[start:])
+    }
+    return string(b)
+}
+
+func (l Layout) open(b []byte) []byte {
+    switch l.Enclosure {
+    case EncloseBraces:
+        return append(b, '{')
+    case EncloseParens:
+        return append(b, '(')
+    }
+    return b
+}
+
+func (l Layout) close(b []byte) []byte {
+    switch l.Enclosure {
+    case EncloseBraces:
+        return append(b, '}')
+    case EncloseParens:
+        return append(b, ')')
+    }
+    return b
+}
+
+// appendStruct appends u as a GUID struct initializer
+func appendStruct(b []byte, u UUID) []byte {
+    b = append(b, "{0x"...)
+    b = hex.AppendEncode(b, u[0:4])
+    b = append(b, ",0x"...)
+    b = hex.AppendEncode(b, u[4:6])
+    b = append(b, ",0x"...)
+    b = hex.AppendEncode(b, u[6:8])
+    b = append(b, ",{"...)
+    for i := 8; i < Size; i++ {
+        if i > 8 {
+            b = append(b, ',')
+        }
+        b = append(b, "0x"...)
+        b = hex.AppendEncode(b, u[i:i+1])
+    }
+    return append(b, "}}"...)
+}
+
+// upperHex converts the hex letters a-f in b to uppercase, leaving the x
+// of 0x prefixes alone
+func upperHex(b []byte) {
+    for i, c := range b {
+        if c >= 'a' && c <= 'f' {
+            b[i] = c - 'a' + 'A'
+        }
+    }
+}
@@ -0,0 +1,44 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestFormatLayout(t *testing.T) {
+    u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+    tests := []struct {
+        layout Layout
+        want   string
+    }{
+        {LayoutCanonical, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+        {LayoutCompact, "6ba7b8109dad11d180b400c04fd430c8"},
+        {LayoutBraced, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+        {LayoutURN, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+        {Layout{Upper: true}, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+        {Layout{Grouping: GroupCompact, Enclosure: EncloseParens}, "(6ba7b8109dad11d180b400c04fd430c8)"},
+        {Layout{Upper: true, Enclosure: EncloseBraces}, "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"},
+        {Layout{URN: true, Upper: true}, "urn:uuid:6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+        {Layout{Grouping: GroupStruct, Upper: true, Enclosure: EncloseParens},
+            "{0x6BA7B810,0x9DAD,0x11D1,{0x80,0xB4,0x00,0xC0,0x4F,0xD4,0x30,0xC8}}"},
+    }
+    for _, tt := range tests {
+        assert.Equal(t, tt.want, u.Format(tt.layout), "%+v", tt.layout)
+    }
+
+    assert.Equal(t, u.String(), u.Format(Layout{}))
+    assert.Equal(t, u.URN(), u.Format(LayoutURN))
+    assert.Equal(t, u.BracedString(), u.Format(LayoutBraced))
+    assert.Equal(t, u.UpperString(), u.Format(Layout{Upper: true}))
+}
+
+func TestFormatLayoutParses(t *testing.T) {
+    u := New()
+    for _, l := range []Layout{LayoutCanonical, LayoutCompact, LayoutBraced, {Upper: true}} {
+        parsed, err := Parse(u.Format(l))
+        require.NoError(t, err)
+        assert.Equal(t, u, parsed)
+    }
+}
@@ -0,0 +1,386 @@
+// Package ledger provides an append-only on-disk record of issued UUIDs,
+// for audits that need to prove when and for what each ID was minted
+package ledger
+
+import (
+    "bufio"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "hash/crc32"
+    "io"
+    "math"
+    "os"
+    "path/filepath"
+    "sync"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// magic starts every ledger file and identifies its format version
+const magic = "UUIDLDG1"
+
+// recordOverhead is the size of a record without its label: the UUID, the
+// timestamp, the label length and the checksum
+const recordOverhead = uuid.Size + 8 + 2 + 4
+
+var (
+    // ErrDuplicate is returned by Append for an ID already in the ledger
+    ErrDuplicate = errors.New("ID already recorded")
+    // ErrCorrupt is returned by Open when a record fails its checksum
+    ErrCorrupt = errors.New("ledger is corrupt")
+)
+
+// Entry is a single issued ID
+type Entry struct {
+    ID    uuid.UUID
+    Time  time.Time
+    Label string
+}
+
+// Ledger is an append-only file of Entries with an in-memory index by ID.
+// Records are checksummed; a record cut short by a crash is discarded when
+// the ledger is reopened. A Ledger is safe for concurrent use.
+type Ledger struct {
+    mu    sync.Mutex
+    path  string
+    f     *os.File
+    w     *bufio.Writer
+    size  int64
+    index map[uuid.UUID]int64
+}
+
+// Open opens the ledger at path, creating it if it does not exist
+func Open(path string) (*Ledger, error) {
+    f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+    if err != nil {
+        return nil, err
+    }
+    l := &Ledger{path: path, f: f}
+    if err := l.load(); err != nil {
+        f.Close()
+        return nil, err
+    }
+    return l, nil
+}
+
+// load validates the file, builds the index and positions the writer at
+// the end of the last complete record
+func (l *Ledger) load() error {
+    info, err := l.f.Stat()
+    if err != nil {
+        return err
+    }
+    if info.Size() == 0 {
+        if _, err := l.f.WriteString(magic); err != nil {
+            return err
+        }
+        info, err = l.f.Stat()
+        if err != nil {
+            return err
+        }
+    }
+
+    var header [len(magic)]byte
+    if _, err := l.f.ReadAt(header[:], 0); err != nil || string(header[:]) != magic {
+        return fmt.Errorf("%s is not a ledger file", l.path)
+    }
+
+    l.index = make(map[uuid.UUID]int64)
+    l.size = int64(len(magic))
+    r := bufio.NewReader(io.NewSectionReader(l.f, l.size, info.Size()-l.size))
+    for {
+        e, n, err := readRecord(r)
+        if errors.Is(err, io.EOF) {
+            break
+        }
+        if errors.Is(err, io.ErrUnexpectedEOF) {
+            // Torn final write: drop the partial record
+            if err := l.f.Truncate(l.size); err != nil {
+                return err
+            }
+            break
+        }
+        if err != nil {
+            return fmt.Errorf("%w: record at offset %d: %v", ErrCorrupt, l.size, err)
+        }
+        l.index[e.ID] = l.size
+        l.size += int64(n)
+    }
+
+    if _, err := l.f.Seek(l.size, io.SeekStart); err != nil {
+        return err
+    }
+    l.w = bufio.NewWriter(l.f)
+    return nil
+}
+
+// Append records e. It fails with ErrDuplicate if e.ID was recorded
+// before. Records are buffered; call Sync to make them durable.
+func (l *Ledger) Append(e Entry) error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.append(e)
+}
+
+// appendSync records e and commits it to stable storage
+func (l *Ledger) appendSync(e Entry) error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    if err := l.append(e); err != nil {
+        return err
+    }
+    return l.sync()
+}
+
+// append records e. Callers must hold l.mu.
+func (l *Ledger) append(e Entry) error {
+    if len(e.Label) > math.MaxUint16 {
+        return fmt.Errorf("label too long: %d bytes", len(e.Label))
+    }
+    if l.index == nil {
+        return os.ErrClosed
+    }
+    if _, ok := l.index[e.ID]; ok {
+        return fmt.Errorf("%w: %s", ErrDuplicate, e.ID)
+    }
+
+    n, err := l.w.Write(encodeRecord(e))
+    if err != nil {
+        return err
+    }
+    l.index[e.ID] = l.size
+    l.size += int64(n)
+    return nil
+}
+
+// Len returns the number of recorded entries
+func (l *Ledger) Len() int {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return len(l.index)
+}
+
+// Lookup returns the entry recorded for id
+func (l *Ledger) Lookup(id uuid.UUID) (Entry, bool, error) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    off, ok := l.index[id]
+    if !ok {
+        return Entry{}, false, nil
+    }
+    if err := l.w.Flush(); err != nil {
+        return Entry{}, false, err
+    }
+    e, _, err := readRecord(bufio.NewReader(io.NewSectionReader(l.f, off, l.size-off)))
+    return e, err == nil, err
+}
+
+// Replay calls fn for every entry in the order they were appended,
+// stopping at the first error fn returns
+func (l *Ledger) Replay(fn func(Entry) error) error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.replay(fn)
+}
+
+// Range calls fn, in append order, for every entry whose time lies in
+// [from, to)
+func (l *Ledger) Range(from, to time.Time, fn func(Entry) error) error {
+    return l.Replay(func(e Entry) error {
+        if e.Time.Before(from) || !e.Time.Before(to) {
+            return nil
+        }
+        return fn(e)
+    })
+}
+
+// replay reads every record. Callers must hold l.mu.
+func (l *Ledger) replay(fn func(Entry) error) error {
+    if l.index == nil {
+        return os.ErrClosed
+    }
+    if err := l.w.Flush(); err != nil {
+        return err
+    }
+    start := int64(len(magic))
+    r := bufio.NewReader(io.NewSectionReader(l.f, start, l.size-start))
+    for {
+        e, _, err := readRecord(r)
+        if errors.Is(err, io.EOF) {
+            return nil
+        }
+        if err != nil {
+            return err
+        }
+        if err := fn(e); err != nil {
+            return err
+        }
+    }
+}
+
+// Compact rewrites the ledger keeping only the entries for which keep
+// returns true, e.g. to drop entries past their retention period, and
+// returns the number of entries removed. The new file replaces the old one
+// atomically, so a crash during compaction leaves the original intact.
+func (l *Ledger) Compact(keep func(Entry) bool) (int, error) {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+
+    tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".compact*")
+    if err != nil {
+        return 0, err
+    }
+    defer os.Remove(tmp.Name())
+
+    w := bufio.NewWriter(tmp)
+    w.WriteString(magic)
+    removed := 0
+    err = l.replay(func(e Entry) error {
+        if !keep(e) {
+            removed++
+            return nil
+        }
+        _, err := w.Write(encodeRecord(e))
+        return err
+    })
+    if err == nil {
+        err = w.Flush()
+    }
+    if err == nil {
+        err = tmp.Sync()
+    }
+    if cerr := tmp.Close(); err == nil {
+        err = cerr
+    }
+    if err != nil {
+        return 0, err
+    }
+
+    // Load the compacted file before it replaces the original, so the
+    // ledger keeps its current file if either step fails
+    f, err := os.OpenFile(tmp.Name(), os.O_RDWR, 0o644)
+    if err != nil {
+        return 0, err
+    }
+    next := &Ledger{path: l.path, f: f}
+    if err := next.load(); err != nil {
+        f.Close()
+        return 0, err
+    }
+    if err := os.Rename(tmp.Name(), l.path); err != nil {
+        f.Close()
+        return 0, err
+    }
+
+    l.f.Close()
+    l.f, l.w, l.size, l.index = next.f, next.w, next.size, next.index
+    return removed, nil
+}
+
+// Sync flushes buffered records and commits them to stable storage
+func (l *Ledger) Sync() error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    return l.sync()
+}
+
+// sync flushes and syncs the file. Callers must hold l.mu.
+func (l *Ledger) sync() error {
+    if l.index == nil {
+        return os.ErrClosed
+    }
+    if err := l.w.Flush(); err != nil {
+        return err
+    }
+    return l.f.Sync()
+}
+
+// Close flushes buffered records and closes the file. It is safe to call
+// more than once.
+func (l *Ledger) Close() error {
+    l.mu.Lock()
+    defer l.mu.Unlock()
+    if l.index == nil {
+        return nil
+    }
+    l.index = nil
+    err := l.w.Flush()
+    if cerr := l.f.Close(); err == nil {
+        err = cerr
+    }
+    return err
+}
+
+// encodeRecord lays out e as UUID, Unix nanoseconds, label length, label
+// and a CRC-32 of everything before it
+func encodeRecord(e Entry) []byte {
+    b := make([]byte, 0, recordOverhead+len(e.Label))
+    b = append(b, e.ID[:]...)
+    b = binary.BigEndian.AppendUint64(b, uint64(e.Time.UnixNano()))
+    b = binary.BigEndian.AppendUint16(b, uint16(len(e.Label)))
+    b = append(b, e.Label...)
+    return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+}
+
+// readRecord decodes the next record from r, returning its size. It
+// returns io.EOF at a clean end of input and io.ErrUnexpectedEOF for a
+// partial record.
+func readRecord(r *bufio.Reader) (Entry, int, error) {
+    var e Entry
+    head := make([]byte, uuid.Size+8+2, recordOverhead)
+    if _, err := io.ReadFull(r, head); err != nil {
+        return e, 0, err
+    }
+    n := int(binary.BigEndian.Uint16(head[uuid.Size+8:]))
+    rec := append(head, make([]byte, n+4)...)
+    if _, err := io.ReadFull(r, rec[len(head):]); err != nil {
+        if errors.Is(err, io.EOF) {
+            err = io.ErrUnexpectedEOF
+        }
+        return e, 0, err
+    }
+
+    body := rec[:len(rec)-4]
+    if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(rec[len(body):]) {
+        return e, 0, errors.New("checksum mismatch")
+    }
+    copy(e.ID[:], body)
+    e.Time = time.Unix(0, int64(binary.BigEndian.Uint64(body[uuid.Size:])))
+    e.Label = string(body[uuid.Size+10:])
+    return e, len(rec), nil
+}
+
+// Recorder is a uuid.Generator that records every UUID it issues in a
+// Ledger under a fixed label. A UUID is only returned once its record has
+// been committed to stable storage, so no issued ID is lost in a crash;
+// this costs a file sync per UUID. Call Ledger.Append and Ledger.Sync
+// directly to record IDs in batches instead.
+type Recorder struct {
+    gen    uuid.Generator
+    ledger *Ledger
+    label  string
+}
+
+// NewRecorder creates a Recorder issuing UUIDs from gen
+func NewRecorder(gen uuid.Generator, l *Ledger, label string) *Recorder {
+    return &Recorder{gen: gen, ledger: l, label: label}
+}
+
+// Generate creates a UUID with the wrapped generator and records it
+func (r *Recorder) Generate() (uuid.UUID, error) {
+    id, err := r.gen.Generate()
+    if err != nil {
+        return id, err
+    }
+    if err := r.ledger.appendSync(Entry{ID: id, Time: time.Now(), Label: r.label}); err != nil {
+        return uuid.Nil, err
+    }
+    return id, nil
+}
+
+// Version returns the version of the wrapped generator
+func (r *Recorder) Version() uuid.Version {
+    return r.gen.Version()
+}
@@ -0,0 +1,181 @@
+package ledger
+
+import (
+    "errors"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/Wembie/uuid/pkg/uuid/uuidtest"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func entries(t *testing.T, l *Ledger) []Entry {
+    var all []Entry
+    require.NoError(t, l.Replay(func(e Entry) error {
+        all = append(all, e)
+        return nil
+    }))
+    return all
+}
+
+func TestLedgerAppendReplay(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "ids.ledger")
+    l, err := Open(path)
+    require.NoError(t, err)
+
+    base := time.Unix(1700000000, 0)
+    var ids []uuid.UUID
+    for i := 0; i < 10; i++ {
+        id := uuid.New()
+        ids = append(ids, id)
+        require.NoError(t, l.Append(Entry{ID: id, Time: base.Add(time.Duration(i) * time.Minute), Label: "orders"}))
+    }
+    assert.ErrorIs(t, l.Append(Entry{ID: ids[3]}), ErrDuplicate)
+    assert.Equal(t, 10, l.Len())
+
+    e, ok, err := l.Lookup(ids[4])
+    require.NoError(t, err)
+    require.True(t, ok)
+    assert.Equal(t, "orders", e.Label)
+    assert.True(t, e.Time.Equal(base.Add(4*time.Minute)))
+
+    _, ok, err = l.Lookup(uuid.New())
+    require.NoError(t, err)
+    assert.False(t, ok)
+
+    var inRange []uuid.UUID
+    require.NoError(t, l.Range(base.Add(2*time.Minute), base.Add(5*time.Minute), func(e Entry) error {
+        inRange = append(inRange, e.ID)
+        return nil
+    }))
+    assert.Equal(t, ids[2:5], inRange)
+
+    require.NoError(t, l.Sync())
+    require.NoError(t, l.Close())
+    require.NoError(t, l.Close())
+    assert.ErrorIs(t, l.Append(Entry{ID: uuid.New()}), os.ErrClosed)
+
+    // Reopening replays the same entries and keeps the index
+    l, err = Open(path)
+    require.NoError(t, err)
+    defer l.Close()
+    all := entries(t, l)
+    require.Len(t, all, 10)
+    for i, e := range all {
+        assert.Equal(t, ids[i], e.ID)
+    }
+    assert.ErrorIs(t, l.Append(Entry{ID: ids[0]}), ErrDuplicate)
+
+    stop := errors.New("stop")
+    assert.Equal(t, stop, l.Replay(func(Entry) error { return stop }))
+}
+
+func TestLedgerTornWrite(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "ids.ledger")
+    l, err := Open(path)
+    require.NoError(t, err)
+    a, b := uuid.New(), uuid.New()
+    require.NoError(t, l.Append(Entry{ID: a, Time: time.Now(), Label: "x"}))
+    require.NoError(t, l.Append(Entry{ID: b, Time: time.Now(), Label: "y"}))
+    require.NoError(t, l.Close())
+
+    info, err := os.Stat(path)
+    require.NoError(t, err)
+    require.NoError(t, os.Truncate(path, info.Size()-3))
+
+    l, err = Open(path)
+    require.NoError(t, err)
+    all := entries(t, l)
+    require.Len(t, all, 1)
+    assert.Equal(t, a, all[0].ID)
+
+    // The partial record is gone, so b can be recorded again
+    require.NoError(t, l.Append(Entry{ID: b, Time: time.Now()}))
+    require.NoError(t, l.Close())
+}
+
+func TestLedgerCorrupt(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "ids.ledger")
+    l, err := Open(path)
+    require.NoError(t, err)
+    require.NoError(t, l.Append(Entry{ID: uuid.New(), Time: time.Now(), Label: "x"}))
+    require.NoError(t, l.Close())
+
+    data, err := os.ReadFile(path)
+    require.NoError(t, err)
+    data[len(magic)+20] ^= 0xff
+    require.NoError(t, os.WriteFile(path, data, 0o644))
+    _, err = Open(path)
+    assert.ErrorIs(t, err, ErrCorrupt)
+
+    other := filepath.Join(t.TempDir(), "other")
+    require.NoError(t, os.WriteFile(other, []byte("not a ledger"), 0o644))
+    _, err = Open(other)
+    assert.Error(t, err)
+}
+
+func TestLedgerCompact(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "ids.ledger")
+    l, err := Open(path)
+    require.NoError(t, err)
+    defer l.Close()
+
+    cutoff := time.Unix(1700000000, 0)
+    old, recent := uuid.New(), uuid.New()
+    require.NoError(t, l.Append(Entry{ID: old, Time: cutoff.Add(-time.Hour)}))
+    require.NoError(t, l.Append(Entry{ID: recent, Time: cutoff.Add(time.Hour)}))
+
+    removed, err := l.Compact(func(e Entry) bool { return !e.Time.Before(cutoff) })
+    require.NoError(t, err)
+    assert.Equal(t, 1, removed)
+    assert.Equal(t, 1, l.Len())
+
+    _, ok, err := l.Lookup(old)
+    require.NoError(t, err)
+    assert.False(t, ok)
+
+    // Appending continues on the compacted file
+    next := uuid.New()
+    require.NoError(t, l.Append(Entry{ID: next, Time: cutoff.Add(2 * time.Hour)}))
+    all := entries(t, l)
+    require.Len(t, all, 2)
+    assert.Equal(t, recent, all[0].ID)
+    assert.Equal(t, next, all[1].ID)
+
+    matches, err := filepath.Glob(path + ".compact*")
+    require.NoError(t, err)
+    assert.Empty(t, matches)
+}
+
+func TestRecorder(t *testing.T) {
+    l, err := Open(filepath.Join(t.TempDir(), "ids.ledger"))
+    require.NoError(t, err)
+    defer l.Close()
+
+    a := uuid.MustParse("00000000-0000-7000-8000-000000000001")
+    r := NewRecorder(uuidtest.NewMockGenerator(a, a), l, "invoices")
+    assert.Equal(t, uuid.VersionUnixTime, r.Version())
+
+    assert.Equal(t, a, uuid.Must(r.Generate()))
+    e, ok, err := l.Lookup(a)
+    require.NoError(t, err)
+    require.True(t, ok)
+    assert.Equal(t, "invoices", e.Label)
+
+    // The record reached the file before the ID was returned
+    reopened, err := Open(l.path)
+    require.NoError(t, err)
+    assert.Equal(t, 1, reopened.Len())
+    require.NoError(t, reopened.Close())
+
+    // A repeated ID is refused rather than issued unrecorded
+    _, err = r.Generate()
+    assert.ErrorIs(t, err, ErrDuplicate)
+
+    _, err = r.Generate()
+    assert.ErrorIs(t, err, uuidtest.ErrExhausted)
+}
@@ -0,0 +1,82 @@
+package uuid
+
+import (
+    "context"
+    "sync"
+)
+
+// Lifecycle is implemented by components that run in the background. Start
+// launches the component, which stops when ctx is cancelled or Close is
+// called; Close drains or discards pending work, waits for background
+// goroutines to exit and is safe to call more than once.
+type Lifecycle interface {
+    Start(ctx context.Context) error
+    Close() error
+}
+
+var (
+    _ Lifecycle = (*Pool)(nil)
+    _ Lifecycle = (*Producer)(nil)
+    _ Lifecycle = (*UUIDGenerator)(nil)
+    _ Lifecycle = RandPool
+)
+
+// RandPool controls the package-wide random pool (see EnableRandPool) as a
+// Lifecycle: Start enables it and Close disables it and wipes its
+// contents, as does cancelling the context passed to the latest Start
+var RandPool Lifecycle = &randPool{}
+
+type randPool struct {
+    mu   sync.Mutex
+    gen  uint64
+    stop func() bool
+}
+
+func (p *randPool) Start(ctx context.Context) error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    p.release()
+    gen := p.gen
+    EnableRandPool()
+    p.stop = context.AfterFunc(ctx, func() {
+        p.mu.Lock()
+        defer p.mu.Unlock()
+        // A later Start or Close owns the pool now
+        if p.gen == gen {
+            p.release()
+            DisableRandPool()
+        }
+    })
+    return nil
+}
+
+func (p *randPool) Close() error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    p.release()
+    DisableRandPool()
+    return nil
+}
+
+// release unregisters the context watcher of the latest Start. Callers
+// must hold p.mu.
+func (p *randPool) release() {
+    if p.stop != nil {
+        p.stop()
+        p.stop = nil
+    }
+    p.gen++
+}
+
+// Start ties the generator to ctx: when ctx is cancelled the generator is
+// closed as by Close, flushing hooks and saving its state. Generators need
+// no Start call otherwise. Close, or a later Start, stops watching ctx.
+func (g *UUIDGenerator) Start(ctx context.Context) error {
+    g.mu.Lock()
+    if g.stopWatch != nil {
+        g.stopWatch()
+    }
+    g.stopWatch = context.AfterFunc(ctx, func() { _ = g.Close() })
+    g.mu.Unlock()
+    return nil
+}
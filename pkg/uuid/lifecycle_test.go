@@ -0,0 +1,83 @@
+package uuid
+
+import (
+    "context"
+    "runtime"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestRandPoolLifecycle(t *testing.T) {
+    t.Cleanup(DisableRandPool)
+
+    require.NoError(t, RandPool.Start(context.Background()))
+    assert.True(t, poolEnabled.Load())
+    require.NoError(t, RandPool.Close())
+    assert.False(t, poolEnabled.Load())
+
+    ctx, cancel := context.WithCancel(context.Background())
+    require.NoError(t, RandPool.Start(ctx))
+    cancel()
+    assert.Eventually(t, func() bool {
+        poolMu.Lock()
+        defer poolMu.Unlock()
+        return !poolEnabled.Load() && poolPos == randPoolSize
+    }, time.Second, time.Millisecond)
+}
+
+func TestGeneratorStart(t *testing.T) {
+    calls := make(chan UUID, 1)
+    g := MustNewGenerator(VersionRandom, WithHook(func(u UUID) { calls <- u }))
+
+    ctx, cancel := context.WithCancel(context.Background())
+    require.NoError(t, g.Start(ctx))
+    uuid, err := g.Generate()
+    require.NoError(t, err)
+    cancel()
+
+    assert.Equal(t, uuid, <-calls)
+    assert.Eventually(t, func() bool {
+        g.hooks.mu.RLock()
+        defer g.hooks.mu.RUnlock()
+        return g.hooks.closed
+    }, time.Second, time.Millisecond)
+    require.NoError(t, g.Close())
+}
+
+func TestRandPoolStaleContext(t *testing.T) {
+    t.Cleanup(DisableRandPool)
+
+    stale, cancelStale := context.WithCancel(context.Background())
+    require.NoError(t, RandPool.Start(stale))
+    require.NoError(t, RandPool.Close())
+    require.NoError(t, RandPool.Start(context.Background()))
+
+    // Cancelling the context of an earlier Start leaves the pool running
+    cancelStale()
+    time.Sleep(10 * time.Millisecond)
+    assert.True(t, poolEnabled.Load())
+    require.NoError(t, RandPool.Close())
+}
+
+func TestLifecycleCloseStopsWatching(t *testing.T) {
+    t.Cleanup(DisableRandPool)
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+
+    before := runtime.NumGoroutine()
+    for i := 0; i < 100; i++ {
+        require.NoError(t, RandPool.Start(ctx))
+        require.NoError(t, RandPool.Close())
+
+        g := MustNewGenerator(VersionRandom)
+        require.NoError(t, g.Start(ctx))
+        require.NoError(t, g.Close())
+    }
+    assert.LessOrEqual(t, runtime.NumGoroutine(), before+5)
+
+    // Nothing is left to run when ctx is finally cancelled
+    assert.Nil(t, RandPool.(*randPool).stop)
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import "fmt"
+
+// EncodeMany writes the canonical form of each of ids into dst, each
+// followed by sep, and returns the number of bytes written,
+// len(ids)*(EncodedLen+len(sep)). It panics if dst is too short. Records
+// have a fixed width, so large batches for ETL jobs can be written with a
+// single buffer and split without scanning.
+func EncodeMany(dst []byte, ids []UUID, sep string) int {
+    stride := EncodedLen + len(sep)
+    if len(ids) > 0 {
+        _ = dst[len(ids)*stride-1]
+    }
+    for n, u := range ids {
+        b := dst[n*stride : n*stride+stride]
+        encodeCanonical(b, u)
+        copy(b[EncodedLen:], sep)
+    }
+    return len(ids) * stride
+}
+
+// DecodeMany decodes canonical UUIDs written by EncodeMany with the same
+// sep from src into dst, stopping when dst is full or src is exhausted;
+// the separator after the last record may be omitted. It returns the
+// number of UUIDs decoded and, if a record is invalid, an error that
+// wraps the decoding error and gives its offset in src.
+func DecodeMany(dst []UUID, src []byte, sep string) (int, error) {
+    stride := EncodedLen + len(sep)
+    n := 0
+    for ; n < len(dst) && len(src) > n*stride; n++ {
+        p := n * stride
+        if len(src)-p < EncodedLen {
+            return n, fmt.Errorf("record %d: %w: %d", n, ErrInvalidLength, len(src)-p)
+        }
+        uuid, err := decodeCanonical(src[p:p+EncodedLen], p)
+        if err != nil {
+            return n, fmt.Errorf("record %d: %w", n, err)
+        }
+        if len(src)-p > EncodedLen && string(src[p+EncodedLen:min(p+stride, len(src))]) != sep {
+            return n, fmt.Errorf("record %d: %w: expected separator at offset %d", n, ErrInvalidFormat, p+EncodedLen)
+        }
+        dst[n] = uuid
+    }
+    return n, nil
+}
@@ -0,0 +1,110 @@
+package uuid
+
+import (
+    "errors"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeMany(t *testing.T) {
+    ids := []UUID{Nil, maxUUID, NamespaceDNS, New(), New()}
+    for _, sep := range []string{"", "\n", ", "} {
+        buf := make([]byte, len(ids)*(EncodedLen+len(sep)))
+        n := EncodeMany(buf, ids, sep)
+        assert.Equal(t, len(buf), n)
+
+        want := make([]string, len(ids))
+        for i, u := range ids {
+            want[i] = u.String() + sep
+        }
+        assert.Equal(t, strings.Join(want, ""), string(buf))
+
+        decoded := make([]UUID, len(ids))
+        got, err := DecodeMany(decoded, buf, sep)
+        require.NoError(t, err, sep)
+        assert.Equal(t, len(ids), got)
+        assert.Equal(t, ids, decoded)
+
+        // The last separator is optional
+        got, err = DecodeMany(decoded, buf[:len(buf)-len(sep)], sep)
+        require.NoError(t, err, sep)
+        assert.Equal(t, len(ids), got)
+
+        // Decoding stops when dst is full
+        got, err = DecodeMany(decoded[:2], buf, sep)
+        require.NoError(t, err, sep)
+        assert.Equal(t, 2, got)
+    }
+
+    assert.Panics(t, func() { EncodeMany(make([]byte, EncodedLen, 2*EncodedLen), ids[:2], "") })
+    assert.Equal(t, 0, EncodeMany(nil, nil, "\n"))
+}
+
+func TestDecodeManyErrors(t *testing.T) {
+    src := []byte(NamespaceDNS.String() + "\n" + NamespaceURL.String() + "\n")
+    dst := make([]UUID, 4)
+
+    bad := append([]byte(nil), src...)
+    bad[EncodedLen+1+35] = 'g'
+    n, err := DecodeMany(dst, bad, "\n")
+    assert.Equal(t, 1, n)
+    assert.ErrorIs(t, err, ErrInvalidFormat)
+    var syntax *SyntaxError
+    require.True(t, errors.As(err, &syntax))
+    assert.Equal(t, EncodedLen+1+35, syntax.Offset)
+
+    n, err = DecodeMany(dst, src[:len(src)-5], "\n")
+    assert.Equal(t, 1, n)
+    assert.ErrorIs(t, err, ErrInvalidLength)
+
+    n, err = DecodeMany(dst, []byte(strings.Replace(string(src), "\n", ";", 1)), "\n")
+    assert.Equal(t, 0, n)
+    assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestEncodeDecodeManyAllocs(t *testing.T) {
+    ids := make([]UUID, 64)
+    for i := range ids {
+        ids[i] = New()
+    }
+    buf := make([]byte, len(ids)*(EncodedLen+1))
+    decoded := make([]UUID, len(ids))
+    allocs := testing.AllocsPerRun(10, func() {
+        EncodeMany(buf, ids, "\n")
+        if _, err := DecodeMany(decoded, buf, "\n"); err != nil {
+            t.Fatal(err)
+        }
+    })
+    assert.Zero(t, allocs)
+    assert.Equal(t, ids, decoded)
+}
+
+func BenchmarkEncodeMany(b *testing.B) {
+    ids := make([]UUID, 1024)
+    for i := range ids {
+        ids[i] = New()
+    }
+    buf := make([]byte, len(ids)*(EncodedLen+1))
+    b.SetBytes(int64(len(buf)))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        EncodeMany(buf, ids, "\n")
+    }
+}
+
+func BenchmarkDecodeMany(b *testing.B) {
+    ids := make([]UUID, 1024)
+    for i := range ids {
+        ids[i] = New()
+    }
+    buf := make([]byte, len(ids)*(EncodedLen+1))
+    EncodeMany(buf, ids, "\n")
+    b.SetBytes(int64(len(buf)))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        DecodeMany(ids, buf, "\n")
+    }
+}
@@ -0,0 +1,92 @@
+package uuid
+
+import "sync"
+
+// VersionAlert describes a UUID whose version falls outside the expected mix
+type VersionAlert struct {
+    UUID    UUID
+    Version Version
+    // Seen is the number of UUIDs observed so far, including this one
+    Seen uint64
+}
+
+// MonitorOptions configures a VersionMonitor
+type MonitorOptions struct {
+    // Expected lists the versions the stream should contain. If empty, the
+    // expected set is learned from the first Warmup observations.
+    Expected []Version
+    // Warmup is the number of observations used to learn the expected set
+    // when Expected is empty
+    Warmup int
+    // OnAlert is called once per unexpected version, the first time it is
+    // observed
+    OnAlert func(VersionAlert)
+}
+
+// VersionMonitor watches a stream of UUIDs and raises an alert when the
+// version mix changes unexpectedly, e.g. V4 values showing up in V7 traffic
+// after an upstream deploy regression. It is safe for concurrent use.
+type VersionMonitor struct {
+    mu       sync.Mutex
+    opts     MonitorOptions
+    expected [16]bool
+    alerted  [16]bool
+    counts   [16]uint64
+    seen     uint64
+    alerts   uint64
+}
+
+// NewVersionMonitor creates a VersionMonitor
+func NewVersionMonitor(opts MonitorOptions) *VersionMonitor {
+    m := &VersionMonitor{opts: opts}
+    for _, v := range opts.Expected {
+        m.expected[v&0x0f] = true
+    }
+    return m
+}
+
+// Observe records a UUID from the stream
+func (m *VersionMonitor) Observe(u UUID) {
+    version := u.Version()
+
+    m.mu.Lock()
+    m.seen++
+    m.counts[version]++
+
+    learning := len(m.opts.Expected) == 0 && m.seen <= uint64(m.opts.Warmup)
+    if learning {
+        m.expected[version] = true
+    }
+
+    var alert *VersionAlert
+    if !m.expected[version] && !m.alerted[version] {
+        m.alerted[version] = true
+        m.alerts++
+        alert = &VersionAlert{UUID: u, Version: version, Seen: m.seen}
+    }
+    m.mu.Unlock()
+
+    if alert != nil && m.opts.OnAlert != nil {
+        m.opts.OnAlert(*alert)
+    }
+}
+
+// Counts returns the number of UUIDs observed per version
+func (m *VersionMonitor) Counts() map[Version]uint64 {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    counts := make(map[Version]uint64)
+    for v, n := range m.counts {
+        if n > 0 {
+            counts[Version(v)] = n
+        }
+    }
+    return counts
+}
+
+// Alerts returns the number of alerts raised so far
+func (m *VersionMonitor) Alerts() uint64 {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return m.alerts
+}
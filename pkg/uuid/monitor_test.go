@@ -0,0 +1,45 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestVersionMonitorExpected(t *testing.T) {
+    var alerts []VersionAlert
+    m := NewVersionMonitor(MonitorOptions{
+        Expected: []Version{VersionReorderedTime},
+        OnAlert:  func(a VersionAlert) { alerts = append(alerts, a) },
+    })
+
+    for i := 0; i < 3; i++ {
+        m.Observe(Must(NewV6()))
+    }
+    m.Observe(New())
+    m.Observe(New())
+
+    require.Len(t, alerts, 1)
+    assert.Equal(t, VersionRandom, alerts[0].Version)
+    assert.Equal(t, uint64(4), alerts[0].Seen)
+    assert.Equal(t, uint64(1), m.Alerts())
+    assert.Equal(t, map[Version]uint64{VersionReorderedTime: 3, VersionRandom: 2}, m.Counts())
+}
+
+func TestVersionMonitorWarmup(t *testing.T) {
+    var alerts []VersionAlert
+    m := NewVersionMonitor(MonitorOptions{
+        Warmup:  2,
+        OnAlert: func(a VersionAlert) { alerts = append(alerts, a) },
+    })
+
+    m.Observe(New())
+    m.Observe(New())
+    m.Observe(New())
+    assert.Empty(t, alerts)
+
+    m.Observe(Must(NewV1()))
+    require.Len(t, alerts, 1)
+    assert.Equal(t, VersionTimeBased, alerts[0].Version)
+}
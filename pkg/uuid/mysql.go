@@ -0,0 +1,32 @@
+package uuid
+
+import "fmt"
+
+// ToMySQLOrdered returns u in the byte order produced by MySQL's
+// UUID_TO_BIN(uuid, 1): the time-high-and-version and time-mid fields are
+// moved before time-low, so V1 UUIDs stored in a BINARY(16) column sort
+// chronologically. Values written by the application and by
+// UUID_TO_BIN(..., 1) in SQL compare identically in indexes.
+func (u UUID) ToMySQLOrdered() []byte {
+    b := make([]byte, Size)
+    copy(b[0:2], u[6:8])
+    copy(b[2:4], u[4:6])
+    copy(b[4:8], u[0:4])
+    copy(b[8:], u[8:])
+    return b
+}
+
+// FromMySQLOrdered decodes 16 bytes in the order produced by
+// UUID_TO_BIN(uuid, 1), the inverse of ToMySQLOrdered and equivalent to
+// BIN_TO_UUID(b, 1)
+func FromMySQLOrdered(b []byte) (UUID, error) {
+    var uuid UUID
+    if len(b) != Size {
+        return uuid, fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(b))
+    }
+    copy(uuid[0:4], b[4:8])
+    copy(uuid[4:6], b[2:4])
+    copy(uuid[6:8], b[0:2])
+    copy(uuid[8:], b[8:])
+    return uuid, nil
+}
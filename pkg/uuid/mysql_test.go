@@ -0,0 +1,46 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "sort"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestToMySQLOrdered(t *testing.T) {
+    // Example from the MySQL reference manual for UUID_TO_BIN
+    u := MustParse("6ccd780c-baba-1026-9564-5b8c656024db")
+    b := u.ToMySQLOrdered()
+    assert.Equal(t, "1026baba6ccd780c95645b8c656024db", hex.EncodeToString(b))
+
+    back, err := FromMySQLOrdered(b)
+    require.NoError(t, err)
+    assert.Equal(t, u, back)
+
+    _, err = FromMySQLOrdered(b[:15])
+    assert.Error(t, err)
+}
+
+func TestMySQLOrderedSortsV1(t *testing.T) {
+    clock := &virtualClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), step: 7 * time.Minute}
+    gen := MustNewGenerator(VersionTimeBased, WithClock(clock), WithRandomNode())
+
+    var ids []UUID
+    var keys [][]byte
+    for i := 0; i < 50; i++ {
+        u := Must(gen.Generate())
+        ids = append(ids, u)
+        keys = append(keys, u.ToMySQLOrdered())
+    }
+    assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+        return string(keys[i]) < string(keys[j])
+    }))
+    for i, k := range keys {
+        back, err := FromMySQLOrdered(k)
+        require.NoError(t, err)
+        assert.Equal(t, ids[i], back)
+    }
+}
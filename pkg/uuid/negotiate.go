@@ -0,0 +1,117 @@
+package uuid
+
+import (
+    "fmt"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// Capabilities describes which UUID versions a service can handle, so
+// ID-issuing services can negotiate a version with their peers during
+// rolling upgrades. Its text form, used in headers and service metadata,
+// is a semicolon-separated list of key=value fields, e.g. "versions=1,4,7";
+// unknown fields are ignored when decoding so new ones can be added.
+type Capabilities struct {
+    Versions []Version
+}
+
+// generatedVersions are the versions this package can generate
+var generatedVersions = []Version{
+    VersionTimeBased,
+    VersionNameBasedMD5,
+    VersionRandom,
+    VersionNameBasedSHA1,
+    VersionReorderedTime,
+    VersionUnixTime,
+    VersionCustom,
+}
+
+// LocalCapabilities returns the capabilities of this package
+func LocalCapabilities() Capabilities {
+    return Capabilities{Versions: append([]Version(nil), generatedVersions...)}
+}
+
+// Supports reports whether v is among the capable versions
+func (c Capabilities) Supports(v Version) bool {
+    return containsVersion(c.Versions, v)
+}
+
+// preferredVersions are the versions PreferredVersionFor picks from, in
+// order of preference
+var preferredVersions = []Version{VersionUnixTime, VersionRandom}
+
+// PreferredVersionFor returns the version to issue IDs in for a peer: V7
+// if the peer handles it, V4 otherwise. V4 is the fallback even for peers
+// that do not advertise it, since any UUID consumer accepts random IDs.
+func PreferredVersionFor(peer Capabilities) Version {
+    for _, v := range preferredVersions {
+        if peer.Supports(v) {
+            return v
+        }
+    }
+    return VersionRandom
+}
+
+// String returns the text form of c
+func (c Capabilities) String() string {
+    versions := append([]Version(nil), c.Versions...)
+    sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+    var b strings.Builder
+    b.WriteString("versions=")
+    for i, v := range versions {
+        if i > 0 {
+            b.WriteByte(',')
+        }
+        b.WriteString(strconv.Itoa(int(v)))
+    }
+    return b.String()
+}
+
+// ParseCapabilities decodes the text form of Capabilities
+func ParseCapabilities(s string) (Capabilities, error) {
+    var c Capabilities
+    for _, field := range strings.Split(s, ";") {
+        field = strings.TrimSpace(field)
+        if field == "" {
+            continue
+        }
+        key, value, ok := strings.Cut(field, "=")
+        if !ok {
+            return Capabilities{}, fmt.Errorf("invalid capabilities field: %q", field)
+        }
+        if strings.TrimSpace(key) != "versions" {
+            continue
+        }
+        for _, item := range strings.Split(value, ",") {
+            item = strings.TrimSpace(item)
+            if item == "" {
+                continue
+            }
+            n, err := strconv.Atoi(item)
+            if err != nil || n < 0 || n > 15 {
+                return Capabilities{}, fmt.Errorf("invalid capabilities version: %q", item)
+            }
+            if !c.Supports(Version(n)) {
+                c.Versions = append(c.Versions, Version(n))
+            }
+        }
+    }
+    return c, nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c Capabilities) MarshalText() ([]byte, error) {
+    return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *Capabilities) UnmarshalText(text []byte) error {
+    parsed, err := ParseCapabilities(string(text))
+    if err != nil {
+        return err
+    }
+    *c = parsed
+    return nil
+}
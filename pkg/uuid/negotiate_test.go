@@ -0,0 +1,48 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestPreferredVersionFor(t *testing.T) {
+    assert.Equal(t, VersionUnixTime, PreferredVersionFor(LocalCapabilities()))
+    assert.Equal(t, VersionRandom, PreferredVersionFor(Capabilities{Versions: []Version{VersionRandom}}))
+    assert.Equal(t, VersionRandom, PreferredVersionFor(Capabilities{Versions: []Version{VersionTimeBased}}))
+    assert.Equal(t, VersionRandom, PreferredVersionFor(Capabilities{}))
+}
+
+func TestCapabilitiesText(t *testing.T) {
+    assert.Equal(t, "versions=1,3,4,5,6,7,8", LocalCapabilities().String())
+    assert.Equal(t, "versions=4,7", Capabilities{Versions: []Version{7, 4}}.String())
+    assert.Equal(t, "versions=", Capabilities{}.String())
+
+    c, err := ParseCapabilities("versions=7, 4,4; encodings=base58")
+    require.NoError(t, err)
+    assert.Equal(t, []Version{VersionUnixTime, VersionRandom}, c.Versions)
+
+    c, err = ParseCapabilities("")
+    require.NoError(t, err)
+    assert.Empty(t, c.Versions)
+
+    for _, s := range []string{"versions", "versions=x", "versions=16", "versions=-1"} {
+        _, err := ParseCapabilities(s)
+        assert.Error(t, err, s)
+    }
+}
+
+func TestCapabilitiesJSON(t *testing.T) {
+    type hello struct {
+        Caps Capabilities `json:"caps"`
+    }
+    data, err := json.Marshal(hello{Caps: Capabilities{Versions: []Version{4}}})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"caps":"versions=4"}`, string(data))
+
+    var h hello
+    require.NoError(t, json.Unmarshal([]byte(`{"caps":"versions=4,7"}`), &h))
+    assert.Equal(t, VersionUnixTime, PreferredVersionFor(h.Caps))
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import "fmt"
+
+// WithRandomNode makes a time-based generator use a random 48-bit node ID
+// with the multicast bit set instead of a hardware address, as recommended
+// by RFC 9562 section 6.10, so generated UUIDs do not reveal the host
+func WithRandomNode() Option {
+    return func(g *UUIDGenerator) {
+        g.randomNode = true
+    }
+}
+
+// NodeID returns the node ID used by the generator's time-based UUIDs
+func (g *UUIDGenerator) NodeID() ([]byte, error) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if !g.nodeSet {
+        if err := g.initNode(); err != nil {
+            return nil, err
+        }
+    }
+    node := g.node
+    return node[:], nil
+}
+
+// SetNodeID pins the node ID of the generator's time-based UUIDs to id,
+// which must be exactly 6 bytes long
+func (g *UUIDGenerator) SetNodeID(id []byte) error {
+    if len(id) != 6 {
+        return fmt.Errorf("invalid node ID length: %d", len(id))
+    }
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    copy(g.node[:], id)
+    g.nodeSet = true
+    return nil
+}
+
+// NodeInterface pins the node ID to the hardware address of the named
+// network interface. An empty name selects the first usable interface.
+func (g *UUIDGenerator) NodeInterface(name string) error {
+    node, ok := interfaceAddr(name)
+    if !ok {
+        return fmt.Errorf("no usable hardware address for interface %q", name)
+    }
+    return g.SetNodeID(node[:])
+}
+
+// SetNodeID pins the node ID used by NewV1 and NewV6
+func SetNodeID(id []byte) error {
+    return defaultTimeGenerator.SetNodeID(id)
+}
+
+// NodeInterface pins the node ID used by NewV1 and NewV6 to the hardware
+// address of the named network interface
+func NodeInterface(name string) error {
+    return defaultTimeGenerator.NodeInterface(name)
+}
+
+// NodeID returns the node ID of a time-based (V1, V2 or V6) UUID, or nil
+// for other versions
+func (u UUID) NodeID() []byte {
+    switch u.Version() {
+    case VersionTimeBased, VersionDCESecurity, VersionReorderedTime:
+        node := make([]byte, 6)
+        copy(node, u[10:])
+        return node
+    default:
+        return nil
+    }
+}
+
+// initNode picks the node ID for the generator. Callers must hold g.mu.
+func (g *UUIDGenerator) initNode() error {
+    if !g.randomNode {
+        if hw, ok := interfaceAddr(""); ok {
+            g.node = hw
+            g.nodeSet = true
+            return nil
+        }
+    }
+
+    node, err := g.randomNodeID()
+    if err != nil {
+        return err
+    }
+    g.node = node
+    g.nodeSet = true
+    return nil
+}
+
+// randomNodeID returns a random node ID with the multicast bit set so it can
+// never collide with an IEEE 802 hardware address
+func (g *UUIDGenerator) randomNodeID() ([6]byte, error) {
+    var node [6]byte
+    if err := g.readRandom(node[:]); err != nil {
+        return node, err
+    }
+    node[0] |= 0x01
+    return node, nil
+}
+
+func isZero(b []byte) bool {
+    for _, v := range b {
+        if v != 0 {
+            return false
+        }
+    }
+    return true
+}
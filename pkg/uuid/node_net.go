@@ -0,0 +1,30 @@
+//go:build !tinygo && !js && !wasip1
+
+package uuid
+
+import "net"
+
+// hardwareNodeSupported reports whether hardware addresses can be used as
+// node IDs on this target
+const hardwareNodeSupported = true
+
+// interfaceAddr returns the hardware address of the named interface, or of
+// the first usable interface on the host if name is empty
+func interfaceAddr(name string) ([6]byte, bool) {
+    var node [6]byte
+    ifaces, err := net.Interfaces()
+    if err != nil {
+        return node, false
+    }
+    for _, iface := range ifaces {
+        if name != "" && iface.Name != name {
+            continue
+        }
+        if len(iface.HardwareAddr) < 6 || isZero(iface.HardwareAddr[:6]) {
+            continue
+        }
+        copy(node[:], iface.HardwareAddr)
+        return node, true
+    }
+    return node, false
+}
@@ -0,0 +1,13 @@
+//go:build tinygo || js || wasip1
+
+package uuid
+
+// hardwareNodeSupported reports whether hardware addresses can be used as
+// node IDs on this target
+const hardwareNodeSupported = false
+
+// interfaceAddr reports no hardware address on targets without a network
+// stack, so time-based generators fall back to a random node ID
+func interfaceAddr(name string) ([6]byte, bool) {
+    return [6]byte{}, false
+}
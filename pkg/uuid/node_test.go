@@ -0,0 +1,58 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestWithRandomNode(t *testing.T) {
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime} {
+        gen := MustNewGenerator(version, WithRandomNode())
+
+        first, err := gen.Generate()
+        require.NoError(t, err)
+        second, err := gen.Generate()
+        require.NoError(t, err)
+
+        assert.Equal(t, byte(0x01), first[10]&0x01, "multicast bit must be set")
+        assert.Equal(t, first[10:], second[10:], "node must be stable per generator")
+    }
+}
+
+func TestRandomNodeDiffersPerGenerator(t *testing.T) {
+    a, err := MustNewGenerator(VersionTimeBased, WithRandomNode()).Generate()
+    require.NoError(t, err)
+    b, err := MustNewGenerator(VersionTimeBased, WithRandomNode()).Generate()
+    require.NoError(t, err)
+    assert.NotEqual(t, a[10:], b[10:])
+}
+
+func TestSetNodeID(t *testing.T) {
+    node := []byte{0x02, 0x42, 0xac, 0x11, 0x00, 0x02}
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime} {
+        gen := MustNewGenerator(version, WithRandomNode())
+        require.NoError(t, gen.SetNodeID(node))
+
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, node, uuid.NodeID())
+
+        got, err := gen.NodeID()
+        require.NoError(t, err)
+        assert.Equal(t, node, got)
+    }
+
+    assert.Error(t, MustNewGenerator(VersionTimeBased).SetNodeID([]byte{1, 2, 3}))
+    assert.Error(t, MustNewGenerator(VersionTimeBased).SetNodeID([]byte{1, 2, 3, 4, 5, 6, 7}))
+}
+
+func TestNodeInterfaceUnknown(t *testing.T) {
+    gen := MustNewGenerator(VersionTimeBased)
+    assert.Error(t, gen.NodeInterface("no-such-interface0"))
+}
+
+func TestUUIDNodeIDNonTimeBased(t *testing.T) {
+    assert.Nil(t, New().NodeID())
+}
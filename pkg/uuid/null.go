@@ -0,0 +1,57 @@
+package uuid
+
+import (
+    "bytes"
+    "database/sql/driver"
+)
+
+// NullUUID is a UUID that may be null, for nullable database columns and
+// JSON fields. It follows the sql.NullString convention: Valid is false
+// for SQL NULL and JSON null.
+type NullUUID struct {
+    UUID  UUID
+    Valid bool
+}
+
+// Value implements driver.Valuer, returning nil when not Valid
+func (n NullUUID) Value() (driver.Value, error) {
+    if !n.Valid {
+        return nil, nil
+    }
+    return n.UUID.Value()
+}
+
+// Scan implements sql.Scanner, treating NULL as not Valid
+func (n *NullUUID) Scan(value interface{}) error {
+    if value == nil {
+        n.UUID, n.Valid = Nil, false
+        return nil
+    }
+    if err := n.UUID.Scan(value); err != nil {
+        n.Valid = false
+        return err
+    }
+    n.Valid = true
+    return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing null when not Valid
+func (n NullUUID) MarshalJSON() ([]byte, error) {
+    if !n.Valid {
+        return []byte("null"), nil
+    }
+    return n.UUID.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading null as not Valid
+func (n *NullUUID) UnmarshalJSON(data []byte) error {
+    if bytes.Equal(data, []byte("null")) {
+        *n = NullUUID{}
+        return nil
+    }
+    if err := n.UUID.UnmarshalJSON(data); err != nil {
+        return err
+    }
+    n.Valid = true
+    return nil
+}
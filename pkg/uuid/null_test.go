@@ -0,0 +1,55 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestNullUUIDSQL(t *testing.T) {
+    var n NullUUID
+    require.NoError(t, n.Scan(nil))
+    assert.False(t, n.Valid)
+    v, err := n.Value()
+    require.NoError(t, err)
+    assert.Nil(t, v)
+
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    require.NoError(t, n.Scan(u.String()))
+    assert.Equal(t, NullUUID{UUID: u, Valid: true}, n)
+    v, err = n.Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), v)
+
+    require.NoError(t, n.Scan(u[:]))
+    assert.Equal(t, NullUUID{UUID: u, Valid: true}, n)
+
+    assert.Error(t, n.Scan(42))
+    assert.False(t, n.Valid)
+}
+
+func TestNullUUIDJSON(t *testing.T) {
+    type row struct {
+        Parent NullUUID `json:"parent"`
+    }
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    data, err := json.Marshal(row{})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"parent":null}`, string(data))
+
+    data, err = json.Marshal(row{Parent: NullUUID{UUID: u, Valid: true}})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"parent":"550e8400-e29b-41d4-a716-446655440000"}`, string(data))
+
+    var r row
+    require.NoError(t, json.Unmarshal(data, &r))
+    assert.Equal(t, NullUUID{UUID: u, Valid: true}, r.Parent)
+
+    require.NoError(t, json.Unmarshal([]byte(`{"parent":null}`), &r))
+    assert.Equal(t, NullUUID{}, r.Parent)
+
+    assert.Error(t, json.Unmarshal([]byte(`{"parent":"nope"}`), &r))
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/binary"
+    "fmt"
+    "hash"
+)
+
+const (
+    obfuscateRounds = 8
+    obfuscateHalf   = 30
+    obfuscateMask   = 1<<obfuscateHalf - 1
+)
+
+// Obfuscator applies a keyed, exactly invertible permutation to the
+// time-ordered bits of a UUID so that IDs exposed outside an API boundary
+// do not reveal when, or how quickly, they were created.
+//
+// The permutation is an 8-round Feistel network with an HMAC-SHA256 round
+// function over the 60 bits that carry the V7 timestamp and rand_a field.
+// The remaining 64 bits are left untouched and tweak every round, and the
+// version and variant bits are preserved, so obfuscated IDs still parse as
+// the same version.
+type Obfuscator struct {
+    key []byte
+}
+
+// NewObfuscator creates an Obfuscator from a secret key of at least 16 bytes
+func NewObfuscator(key []byte) (*Obfuscator, error) {
+    if len(key) < 16 {
+        return nil, fmt.Errorf("invalid obfuscation key length: %d", len(key))
+    }
+    return &Obfuscator{key: append([]byte(nil), key...)}, nil
+}
+
+// Obfuscate permutes the time-ordered bits of u
+func (o *Obfuscator) Obfuscate(u UUID) UUID {
+    mac := hmac.New(sha256.New, o.key)
+    left, right := splitTimeBits(u)
+    for round := 0; round < obfuscateRounds; round++ {
+        left, right = right, left^o.round(mac, round, right, u)
+    }
+    return joinTimeBits(u, left, right)
+}
+
+// Deobfuscate reverses Obfuscate
+func (o *Obfuscator) Deobfuscate(u UUID) UUID {
+    mac := hmac.New(sha256.New, o.key)
+    left, right := splitTimeBits(u)
+    for round := obfuscateRounds - 1; round >= 0; round-- {
+        left, right = right^o.round(mac, round, left, u), left
+    }
+    return joinTimeBits(u, left, right)
+}
+
+// round is the Feistel round function keyed by the round number and the
+// untouched second half of the UUID
+func (o *Obfuscator) round(mac hash.Hash, round int, half uint64, u UUID) uint64 {
+    var buf [13]byte
+    buf[0] = byte(round)
+    binary.BigEndian.PutUint32(buf[1:5], uint32(half))
+    copy(buf[5:], u[8:])
+
+    mac.Reset()
+    mac.Write(buf[:])
+    sum := mac.Sum(nil)
+    return uint64(binary.BigEndian.Uint32(sum)) & obfuscateMask
+}
+
+// splitTimeBits extracts the 48-bit timestamp and 12-bit rand_a field as
+// two 30-bit halves
+func splitTimeBits(u UUID) (uint64, uint64) {
+    hi := binary.BigEndian.Uint64(u[:8])
+    bits := (hi>>16)<<12 | hi&0x0fff
+    return bits >> obfuscateHalf, bits & obfuscateMask
+}
+
+// joinTimeBits writes two 30-bit halves back around the version nibble
+func joinTimeBits(u UUID, left, right uint64) UUID {
+    bits := left<<obfuscateHalf | right
+    hi := binary.BigEndian.Uint64(u[:8])
+    hi = (bits>>12)<<16 | hi&0xf000 | bits&0x0fff
+    binary.BigEndian.PutUint64(u[:8], hi)
+    return u
+}
@@ -0,0 +1,39 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestObfuscatorRoundTrip(t *testing.T) {
+    o, err := NewObfuscator([]byte("0123456789abcdef"))
+    require.NoError(t, err)
+
+    for i := 0; i < 100; i++ {
+        id := New()
+        hidden := o.Obfuscate(id)
+        assert.NotEqual(t, id, hidden)
+        assert.Equal(t, id.Version(), hidden.Version())
+        assert.Equal(t, id.Variant(), hidden.Variant())
+        assert.Equal(t, id[8:], hidden[8:])
+        assert.Equal(t, id, o.Deobfuscate(hidden))
+    }
+}
+
+func TestObfuscatorHidesOrder(t *testing.T) {
+    o, err := NewObfuscator([]byte("0123456789abcdef"))
+    require.NoError(t, err)
+
+    a := MustParse("01890a5d-ac96-7000-8000-000000000000")
+    b := MustParse("01890a5d-ac97-7000-8000-000000000000")
+    ha, hb := o.Obfuscate(a), o.Obfuscate(b)
+    assert.NotEqual(t, a[:4], ha[:4])
+    assert.NotEqual(t, ha[:6], hb[:6])
+}
+
+func TestObfuscatorKeyLength(t *testing.T) {
+    _, err := NewObfuscator([]byte("short"))
+    assert.Error(t, err)
+}
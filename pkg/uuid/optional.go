@@ -0,0 +1,105 @@
+package uuid
+
+import (
+    "bytes"
+    "database/sql/driver"
+)
+
+// Optional is a UUID field that distinguishes three states, as needed by
+// PATCH-style updates: absent (the field was omitted), null (the field was
+// explicitly set to null) and present (the field holds a UUID). The zero
+// value is absent.
+//
+// When decoding JSON, a field missing from the input stays absent, a JSON
+// null makes it null and a string makes it present. When encoding, absent
+// and null are both written as null; tag the field `json:",omitzero"` on
+// Go 1.24 or later to omit absent fields instead.
+type Optional struct {
+    UUID UUID
+    // Set reports whether the field was provided, as null or a UUID
+    Set bool
+    // Valid reports whether the field holds a UUID
+    Valid bool
+}
+
+// Some returns a present Optional holding u
+func Some(u UUID) Optional {
+    return Optional{UUID: u, Set: true, Valid: true}
+}
+
+// Null returns an Optional explicitly set to null
+func Null() Optional {
+    return Optional{Set: true}
+}
+
+// IsAbsent reports whether the field was omitted
+func (o Optional) IsAbsent() bool {
+    return !o.Set
+}
+
+// IsNull reports whether the field was explicitly set to null
+func (o Optional) IsNull() bool {
+    return o.Set && !o.Valid
+}
+
+// IsPresent reports whether the field holds a UUID
+func (o Optional) IsPresent() bool {
+    return o.Set && o.Valid
+}
+
+// Get returns the UUID and whether the field holds one
+func (o Optional) Get() (UUID, bool) {
+    return o.UUID, o.IsPresent()
+}
+
+// IsZero reports whether the field is absent, for the omitzero JSON option
+func (o Optional) IsZero() bool {
+    return o.IsAbsent()
+}
+
+// MarshalJSON implements json.Marshaler
+func (o Optional) MarshalJSON() ([]byte, error) {
+    if !o.IsPresent() {
+        return []byte("null"), nil
+    }
+    return o.UUID.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It is only called for fields
+// present in the input, which is what separates absent from null.
+func (o *Optional) UnmarshalJSON(data []byte) error {
+    if bytes.Equal(data, []byte("null")) {
+        *o = Null()
+        return nil
+    }
+    var u UUID
+    if err := u.UnmarshalJSON(data); err != nil {
+        return err
+    }
+    *o = Some(u)
+    return nil
+}
+
+// Value implements driver.Valuer. Absent and null both map to SQL NULL;
+// callers building partial updates should skip absent fields.
+func (o Optional) Value() (driver.Value, error) {
+    if !o.IsPresent() {
+        return nil, nil
+    }
+    return o.UUID.Value()
+}
+
+// Scan implements sql.Scanner. A scanned column is always set, and NULL
+// makes it null.
+func (o *Optional) Scan(value interface{}) error {
+    if value == nil {
+        *o = Null()
+        return nil
+    }
+    var u UUID
+    if err := u.Scan(value); err != nil {
+        return err
+    }
+    *o = Some(u)
+    return nil
+}
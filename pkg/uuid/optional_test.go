@@ -0,0 +1,76 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestOptionalJSON(t *testing.T) {
+    type patch struct {
+        Owner Optional `json:"owner"`
+    }
+    id := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    for input, want := range map[string]Optional{
+        `{}`:              {},
+        `{"owner":null}`:  Null(),
+        `{"owner":"550e8400-e29b-41d4-a716-446655440000"}`: Some(id),
+    } {
+        var p patch
+        require.NoError(t, json.Unmarshal([]byte(input), &p), input)
+        assert.Equal(t, want, p.Owner, input)
+    }
+
+    var p patch
+    assert.Error(t, json.Unmarshal([]byte(`{"owner":"nope"}`), &p))
+
+    data, err := json.Marshal(patch{Owner: Some(id)})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"owner":"550e8400-e29b-41d4-a716-446655440000"}`, string(data))
+
+    data, err = json.Marshal(patch{Owner: Null()})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"owner":null}`, string(data))
+}
+
+func TestOptionalStates(t *testing.T) {
+    var absent Optional
+    assert.True(t, absent.IsAbsent())
+    assert.True(t, absent.IsZero())
+    assert.False(t, absent.IsNull())
+    assert.False(t, absent.IsPresent())
+
+    null := Null()
+    assert.False(t, null.IsAbsent())
+    assert.True(t, null.IsNull())
+    assert.False(t, null.IsPresent())
+
+    id := New()
+    present := Some(id)
+    assert.True(t, present.IsPresent())
+    got, ok := present.Get()
+    assert.True(t, ok)
+    assert.Equal(t, id, got)
+    _, ok = null.Get()
+    assert.False(t, ok)
+}
+
+func TestOptionalSQL(t *testing.T) {
+    var o Optional
+    require.NoError(t, o.Scan(nil))
+    assert.True(t, o.IsNull())
+    v, err := o.Value()
+    require.NoError(t, err)
+    assert.Nil(t, v)
+
+    require.NoError(t, o.Scan("550e8400-e29b-41d4-a716-446655440000"))
+    assert.True(t, o.IsPresent())
+    v, err = o.Value()
+    require.NoError(t, err)
+    assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", v)
+
+    assert.Error(t, o.Scan(42))
+}
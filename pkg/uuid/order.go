@@ -0,0 +1,62 @@
+package uuid
+
+import "sort"
+
+// CompareByVersionTime orders UUIDs by version, then by embedded timestamp
+// for time-based versions, then by raw bytes. It is meant for merging IDs
+// from mixed-version sources, where plain byte order would interleave
+// unrelated layouts.
+func CompareByVersionTime(a, b UUID) int {
+    if va, vb := a.Version(), b.Version(); va != vb {
+        if va < vb {
+            return -1
+        }
+        return 1
+    }
+
+    ta, errA := a.Time()
+    tb, errB := b.Time()
+    if errA == nil && errB == nil && !ta.Equal(tb) {
+        if ta.Before(tb) {
+            return -1
+        }
+        return 1
+    }
+    return a.Compare(b)
+}
+
+// SortByVersionTime sorts ids in place using CompareByVersionTime
+func SortByVersionTime(ids []UUID) {
+    sort.Slice(ids, func(i, j int) bool {
+        return CompareByVersionTime(ids[i], ids[j]) < 0
+    })
+}
+
+// OrderKey returns a sortable key for u that defines a total order across
+// versions. V1 UUIDs have their timestamp fields reordered most
+// significant first, as in V6, so they sort chronologically and interleave
+// with V6 UUIDs of the same time; their version nibble is kept, so the key
+// never collides with that of another UUID. All other UUIDs, whose byte
+// order is already meaningful, are passed through. Comparing keys
+// bytewise gives a consistent order for merge-sorting heterogeneous sets.
+func (u UUID) OrderKey() [16]byte {
+    if u.Version() != VersionTimeBased {
+        return u
+    }
+    key := u
+    putV6Time(&key, v1Time(u))
+    key[6] = key[6]&0x0f | 0x10 // Version 1
+    return key
+}
+
+// CompareByOrderKey compares the OrderKey of a and b
+func CompareByOrderKey(a, b UUID) int {
+    return UUID(a.OrderKey()).Compare(b.OrderKey())
+}
+
+// SortByOrderKey sorts ids in place by OrderKey
+func SortByOrderKey(ids []UUID) {
+    sort.Slice(ids, func(i, j int) bool {
+        return CompareByOrderKey(ids[i], ids[j]) < 0
+    })
+}
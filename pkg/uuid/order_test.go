@@ -0,0 +1,64 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestCompareByVersionTime(t *testing.T) {
+    v1Early := MustParse("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+    // Later timestamp but smaller time_low bytes
+    v1Late := MustParse("0000ab00-9415-11ec-b3c8-9f6bdeced846")
+    v4 := MustParse("00000000-0000-4000-8000-000000000000")
+
+    assert.Equal(t, 1, v1Early.Compare(v1Late))
+    assert.Equal(t, -1, CompareByVersionTime(v1Early, v1Late))
+    assert.Equal(t, -1, CompareByVersionTime(v1Late, v4))
+    assert.Equal(t, 0, CompareByVersionTime(v4, v4))
+}
+
+func TestSortByVersionTime(t *testing.T) {
+    a := MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+    b := MustParse("017f22e2-79b1-7000-8000-000000000000")
+    c := MustParse("11111111-1111-4111-8111-111111111111")
+    d := MustParse("22222222-2222-4222-8222-222222222222")
+
+    ids := []UUID{b, d, a, c}
+    SortByVersionTime(ids)
+    assert.Equal(t, []UUID{c, d, a, b}, ids)
+}
+
+func TestOrderKey(t *testing.T) {
+    v1Early := MustParse("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+    // Later timestamp but smaller time_low bytes
+    v1Late := MustParse("0000ab00-9415-11ec-b3c8-9f6bdeced846")
+    require.Equal(t, 1, v1Early.Compare(v1Late))
+    assert.Equal(t, -1, CompareByOrderKey(v1Early, v1Late))
+
+    // V1 interleaves with V6 by time
+    v6, err := MustParse("ffffffff-9414-11ec-b3c8-9f6bdeced846").ToV6()
+    require.NoError(t, err)
+    ids := []UUID{v1Late, v6, v1Early}
+    SortByOrderKey(ids)
+    assert.Equal(t, []UUID{v1Early, v6, v1Late}, ids)
+
+    // The key keeps the version so V1 never collides with its V6 form
+    converted, err := v1Early.ToV6()
+    require.NoError(t, err)
+    assert.NotEqual(t, v1Early.OrderKey(), converted.OrderKey())
+    assert.Equal(t, VersionTimeBased, UUID(v1Early.OrderKey()).Version())
+
+    // Other versions pass through
+    v4 := New()
+    assert.Equal(t, [16]byte(v4), v4.OrderKey())
+}
+
+func TestRangeContainsOrderKey(t *testing.T) {
+    u := MustParse("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+    r, err := MatchPrefixHex("1ec9414c")
+    require.NoError(t, err)
+    assert.True(t, r.ContainsOrderKey(u))
+    assert.False(t, r.Contains(u))
+}
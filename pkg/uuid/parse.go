@@ -0,0 +1,133 @@
+package uuid
+
+import (
+    "errors"
+    "fmt"
+)
+
+// canonicalDigits are the offsets of the 16 hex digit pairs in the
+// canonical form
+var canonicalDigits = [Size]int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
+
+// ParseText parses a UUID from its text form in b without allocating, for
+// hot paths that read IDs from HTTP headers and other byte buffers. It
+// accepts the canonical and 32-digit compact forms, either of them in
+// braces, after a "urn:uuid:" or "uuid:" prefix.
+func ParseText(b []byte) (UUID, error) {
+    uuid, err := parseText(b)
+    if err != nil {
+        reportTextFailure(string(b), err)
+    }
+    return uuid, err
+}
+
+// parseText decodes the text forms accepted by ParseText from a string or
+// byte slice
+func parseText[T string | []byte](s T) (UUID, error) {
+    var uuid UUID
+    off := urnPrefixLen(s)
+    body := s[off:]
+    if len(body) >= 2 && body[0] == '{' && body[len(body)-1] == '}' {
+        body = body[1 : len(body)-1]
+        off++
+    }
+
+    switch len(body) {
+    case EncodedLen:
+        return decodeCanonical(body, off)
+    case 2 * Size:
+        for i := 0; i < Size; i++ {
+            v, ok := decodeHexPair(body[2*i], body[2*i+1])
+            if !ok {
+                return Nil, badHexPair(body, 2*i, off)
+            }
+            uuid[i] = v
+        }
+        return uuid, nil
+    default:
+        return Nil, fmt.Errorf("%w: %d", ErrInvalidLength, len(body))
+    }
+}
+
+// decodeCanonical decodes the canonical form in body, which holds exactly
+// EncodedLen bytes and starts at offset off of the input
+func decodeCanonical[T string | []byte](body T, off int) (UUID, error) {
+    var uuid UUID
+    if body[8] != '-' || body[13] != '-' || body[18] != '-' || body[23] != '-' {
+        for _, i := range [...]int{8, 13, 18, 23} {
+            if body[i] != '-' {
+                return Nil, &SyntaxError{Offset: off + i, Msg: "expected hyphen"}
+            }
+        }
+    }
+    for i, j := range canonicalDigits {
+        v, ok := decodeHexPair(body[j], body[j+1])
+        if !ok {
+            return Nil, badHexPair(body, j, off)
+        }
+        uuid[i] = v
+    }
+    return uuid, nil
+}
+
+// urnPrefixLen returns the length of a "urn:uuid:" or "uuid:" prefix of s
+// in any case, or 0
+func urnPrefixLen[T string | []byte](s T) int {
+    for _, prefix := range [...]string{"urn:uuid:", "uuid:"} {
+        if len(s) < len(prefix) {
+            continue
+        }
+        match := true
+        for i := 0; i < len(prefix) && match; i++ {
+            c := s[i]
+            if 'A' <= c && c <= 'Z' {
+                c += 'a' - 'A'
+            }
+            match = c == prefix[i]
+        }
+        if match {
+            return len(prefix)
+        }
+    }
+    return 0
+}
+
+// hexValues maps a byte to the value of the hex digit it encodes, or to
+// 0xff if it is not a hex digit
+var hexValues = func() (t [256]byte) {
+    for i := range t {
+        t[i] = 0xff
+    }
+    for i := byte(0); i < 16; i++ {
+        t[hexDigits[i]] = i
+        t["0123456789ABCDEF"[i]] = i
+    }
+    return t
+}()
+
+// decodeHexPair decodes two hex digits into a byte
+func decodeHexPair(hi, lo byte) (byte, bool) {
+    h, l := hexValues[hi], hexValues[lo]
+    return h<<4 | l, h|l < 16
+}
+
+// badHexPair reports the invalid digit of the pair at offset j of body,
+// which starts at offset off of the input
+func badHexPair[T string | []byte](body T, j, off int) error {
+    if hexValues[body[j]] < 16 {
+        j++
+    }
+    if body[j] == '-' {
+        return &SyntaxError{Offset: off + j, Msg: "misplaced hyphen"}
+    }
+    return &SyntaxError{Offset: off + j, Msg: fmt.Sprintf("invalid character %q", string(body[j:j+1]))}
+}
+
+// reportTextFailure reports a failed text decode to the failure hook
+func reportTextFailure(input string, err error) {
+    if errors.Is(err, ErrInvalidLength) {
+        reportFailure(input, FailureLength)
+    } else {
+        reportFailure(input, FailureFormat)
+    }
+}
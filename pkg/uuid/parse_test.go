@@ -0,0 +1,77 @@
+package uuid
+
+import (
+    "errors"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestParseText(t *testing.T) {
+    want := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    for _, s := range []string{
+        "550e8400-e29b-41d4-a716-446655440000",
+        "550E8400-E29B-41D4-A716-446655440000",
+        "550e8400e29b41d4a716446655440000",
+        "{550e8400-e29b-41d4-a716-446655440000}",
+        "{550e8400e29b41d4a716446655440000}",
+        "urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+        "URN:UUID:550e8400-e29b-41d4-a716-446655440000",
+        "uuid:550e8400-e29b-41d4-a716-446655440000",
+    } {
+        got, err := ParseText([]byte(s))
+        require.NoError(t, err, s)
+        assert.Equal(t, want, got, s)
+    }
+
+    tests := []struct {
+        input  string
+        kind   error
+        offset int
+    }{
+        {"", ErrInvalidLength, -1},
+        {"550e8400-e29b-41d4-a716", ErrInvalidLength, -1},
+        {"{550e8400-e29b-41d4-a716-446655440000", ErrInvalidLength, -1},
+        {"urn:oid:550e8400-e29b-41d4-a716-446655440000", ErrInvalidLength, -1},
+        {"550e8400-e29b-41d4-a716-44665544000g", ErrInvalidFormat, 35},
+        {"550e8400-e29b-41d4-a716-4466554400g0", ErrInvalidFormat, 34},
+        {"550e8400_e29b-41d4-a716-446655440000", ErrInvalidFormat, 8},
+        {"urn:uuid:{550e8400-e29b-41d4-a716-44665544000g}", ErrInvalidFormat, 45},
+        {"550e8400-e29b41d4-a716-4466554400000", ErrInvalidFormat, 13},
+    }
+    for _, tt := range tests {
+        _, err := ParseText([]byte(tt.input))
+        require.Error(t, err, tt.input)
+        assert.ErrorIs(t, err, tt.kind, tt.input)
+        var syntax *SyntaxError
+        if errors.As(err, &syntax) {
+            assert.Equal(t, tt.offset, syntax.Offset, tt.input)
+        } else {
+            assert.Equal(t, -1, tt.offset, tt.input)
+        }
+    }
+}
+
+func TestParseTextAllocs(t *testing.T) {
+    for _, s := range []string{
+        "550e8400-e29b-41d4-a716-446655440000",
+        "urn:uuid:{550e8400e29b41d4a716446655440000}",
+    } {
+        b := []byte(s)
+        allocs := testing.AllocsPerRun(100, func() {
+            if _, err := ParseText(b); err != nil {
+                t.Fatal(err)
+            }
+        })
+        assert.Zero(t, allocs, s)
+    }
+}
+
+func BenchmarkParseText(b *testing.B) {
+    text := []byte(New().String())
+    b.ReportAllocs()
+    for i := 0; i < b.N; i++ {
+        ParseText(text)
+    }
+}
@@ -0,0 +1,102 @@
+package uuid
+
+import (
+    "errors"
+    "io/fs"
+    "os"
+    "path/filepath"
+)
+
+// StateStore persists generator state (see ExportState) across process
+// restarts
+type StateStore interface {
+    // Load returns the saved state, or nil if nothing was saved yet
+    Load() ([]byte, error)
+    // Save replaces the saved state
+    Save(state []byte) error
+}
+
+// FileStateStore is a StateStore backed by a single file, which is
+// replaced atomically on every save
+type FileStateStore struct {
+    Path string
+}
+
+// NewFileStateStore creates a FileStateStore for the file at path
+func NewFileStateStore(path string) *FileStateStore {
+    return &FileStateStore{Path: path}
+}
+
+// Load reads the state file, returning nil if it does not exist
+func (s *FileStateStore) Load() ([]byte, error) {
+    state, err := os.ReadFile(s.Path)
+    if errors.Is(err, fs.ErrNotExist) {
+        return nil, nil
+    }
+    return state, err
+}
+
+// Save writes the state file via a temporary file and a rename
+func (s *FileStateStore) Save(state []byte) error {
+    tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp*")
+    if err != nil {
+        return err
+    }
+    defer os.Remove(tmp.Name())
+
+    if _, err := tmp.Write(state); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := tmp.Sync(); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := tmp.Close(); err != nil {
+        return err
+    }
+    return os.Rename(tmp.Name(), s.Path)
+}
+
+// WithStateStore makes a time-based generator reload its node ID and clock
+// sequence from store when it is created and save them back right away and
+// on Close. The reloaded clock sequence is incremented, so a restarted
+// process never repeats a timestamp issued before the restart, even if the
+// clock was set back in the meantime.
+func WithStateStore(store StateStore) Option {
+    return func(g *UUIDGenerator) {
+        g.store = store
+    }
+}
+
+// restoreState loads, initializes and saves the persisted state
+func (g *UUIDGenerator) restoreState() error {
+    state, err := g.store.Load()
+    if err != nil {
+        return err
+    }
+    if state != nil {
+        if err := g.ImportState(state); err != nil {
+            return err
+        }
+    }
+
+    g.mu.Lock()
+    if !g.nodeSet {
+        err = g.initNode()
+    }
+    if err == nil {
+        if g.seqSet {
+            // Move past any timestamp issued before the restart
+            g.clockSeq = (g.clockSeq + 1) & 0x3fff
+        } else {
+            err = g.reseed()
+        }
+    }
+    g.mu.Unlock()
+    if err != nil {
+        return err
+    }
+
+    return g.store.Save(g.ExportState())
+}
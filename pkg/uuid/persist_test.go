@@ -0,0 +1,47 @@
+package uuid
+
+import (
+    "path/filepath"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestFileStateStore(t *testing.T) {
+    store := NewFileStateStore(filepath.Join(t.TempDir(), "uuid.state"))
+
+    state, err := store.Load()
+    require.NoError(t, err)
+    assert.Nil(t, state)
+
+    require.NoError(t, store.Save([]byte{1, 2, 3}))
+    state, err = store.Load()
+    require.NoError(t, err)
+    assert.Equal(t, []byte{1, 2, 3}, state)
+}
+
+func TestWithStateStore(t *testing.T) {
+    store := NewFileStateStore(filepath.Join(t.TempDir(), "uuid.state"))
+
+    first, err := NewGenerator(VersionTimeBased, WithRandomNode(), WithStateStore(store))
+    require.NoError(t, err)
+    a := Must(first.Generate())
+    require.NoError(t, first.Close())
+
+    // A restarted process keeps the node and moves to a new clock sequence
+    second, err := NewGenerator(VersionTimeBased, WithRandomNode(), WithStateStore(store))
+    require.NoError(t, err)
+    b := Must(second.Generate())
+
+    assert.Equal(t, a.NodeID(), b.NodeID())
+    assert.NotEqual(t, a.ClockSequence(), b.ClockSequence())
+}
+
+func TestWithStateStoreCorrupt(t *testing.T) {
+    store := NewFileStateStore(filepath.Join(t.TempDir(), "uuid.state"))
+    require.NoError(t, store.Save([]byte("garbage")))
+
+    _, err := NewGenerator(VersionReorderedTime, WithStateStore(store))
+    assert.Error(t, err)
+}
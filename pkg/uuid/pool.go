@@ -0,0 +1,92 @@
+package uuid
+
+import (
+    "context"
+    "errors"
+    "sync"
+)
+
+// errClosed is returned when starting a closed component
+var errClosed = errors.New("already closed")
+
+// Pool keeps a buffer of pre-generated UUIDs topped up by a background
+// goroutine, taking generation off the latency-sensitive path. When the
+// buffer is empty, or the pool is not running, Get generates directly.
+type Pool struct {
+    gen Generator
+    ch  chan UUID
+
+    mu     sync.Mutex
+    cancel context.CancelFunc
+    done   chan struct{}
+    closed bool
+}
+
+// NewPool creates a pool holding up to size UUIDs from gen. Call Start to
+// begin refilling it.
+func NewPool(gen Generator, size int) *Pool {
+    return &Pool{gen: gen, ch: make(chan UUID, size)}
+}
+
+// Start launches the refill goroutine, which runs until ctx is cancelled,
+// Close is called or generation fails
+func (p *Pool) Start(ctx context.Context) error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    if p.closed {
+        return errClosed
+    }
+    if p.done != nil {
+        return errStarted
+    }
+    ctx, p.cancel = context.WithCancel(ctx)
+    p.done = make(chan struct{})
+    go p.refill(ctx)
+    return nil
+}
+
+func (p *Pool) refill(ctx context.Context) {
+    defer close(p.done)
+    for {
+        uuid, err := p.gen.Generate()
+        if err != nil {
+            // Get surfaces the error by generating directly
+            return
+        }
+        select {
+        case p.ch <- uuid:
+        case <-ctx.Done():
+            return
+        }
+    }
+}
+
+// Get returns a pooled UUID, or a freshly generated one if none is ready
+func (p *Pool) Get() (UUID, error) {
+    select {
+    case uuid := <-p.ch:
+        return uuid, nil
+    default:
+        return p.gen.Generate()
+    }
+}
+
+// Close stops the refill goroutine, waits for it to exit and discards the
+// UUIDs still buffered, so none are handed out after shutdown. Get keeps
+// working by generating directly.
+func (p *Pool) Close() error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    p.closed = true
+    if p.done != nil {
+        p.cancel()
+        <-p.done
+    }
+    for {
+        select {
+        case <-p.ch:
+        default:
+            return nil
+        }
+    }
+}
@@ -0,0 +1,74 @@
+package uuid
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+    p := NewPool(MustNewGenerator(VersionRandom), 16)
+
+    // Not started: Get generates directly
+    uuid, err := p.Get()
+    require.NoError(t, err)
+    assert.Equal(t, VersionRandom, uuid.Version())
+
+    require.NoError(t, p.Start(context.Background()))
+    assert.Error(t, p.Start(context.Background()))
+    assert.Eventually(t, func() bool { return len(p.ch) == cap(p.ch) }, time.Second, time.Millisecond)
+
+    seen := make(map[UUID]bool)
+    for i := 0; i < 100; i++ {
+        uuid, err := p.Get()
+        require.NoError(t, err)
+        assert.False(t, seen[uuid])
+        seen[uuid] = true
+    }
+
+    require.NoError(t, p.Close())
+    require.NoError(t, p.Close())
+    assert.Zero(t, len(p.ch))
+    assert.Error(t, p.Start(context.Background()))
+
+    _, err = p.Get()
+    assert.NoError(t, err)
+}
+
+func TestPoolGenerationError(t *testing.T) {
+    boom := errors.New("boom")
+    p := NewPool(errGenerator{err: boom}, 4)
+    require.NoError(t, p.Start(context.Background()))
+    _, err := p.Get()
+    assert.ErrorIs(t, err, boom)
+    require.NoError(t, p.Close())
+}
+
+func TestPoolContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    p := NewPool(MustNewGenerator(VersionRandom), 4)
+    require.NoError(t, p.Start(ctx))
+    cancel()
+    select {
+    case <-p.done:
+    case <-time.After(time.Second):
+        t.Fatal("refill goroutine did not exit")
+    }
+    require.NoError(t, p.Close())
+}
+
+type errGenerator struct {
+    err error
+}
+
+func (g errGenerator) Generate() (UUID, error) {
+    return Nil, g.err
+}
+
+func (g errGenerator) Version() Version {
+    return VersionRandom
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import (
+    "fmt"
+    "strings"
+)
+
+// Range is an inclusive range of UUIDs in byte order
+type Range struct {
+    Min UUID
+    Max UUID
+}
+
+// Contains reports whether u lies within the range
+func (r Range) Contains(u UUID) bool {
+    return u.Compare(r.Min) >= 0 && u.Compare(r.Max) <= 0
+}
+
+// MatchPrefixHex converts a hex prefix, such as the first 8 characters of a
+// UUID typed into an admin tool, into the range of UUIDs that start with
+// it, ready for a "BETWEEN min AND max" query. Hyphens and braces in the
+// prefix are ignored and case does not matter.
+func MatchPrefixHex(prefix string) (Range, error) {
+    var r Range
+    digits := strings.NewReplacer("-", "", "{", "", "}", "").Replace(prefix)
+    if len(digits) > 32 {
+        return r, fmt.Errorf("invalid UUID prefix length: %d", len(digits))
+    }
+
+    for i := 0; i < 32; i++ {
+        lo, hi := byte(0x0), byte(0xf)
+        if i < len(digits) {
+            v, ok := fromHexChar(digits[i])
+            if !ok {
+                return Range{}, fmt.Errorf("invalid UUID prefix: bad character %q at offset %d", digits[i], i)
+            }
+            lo, hi = v, v
+        }
+        shift := uint(4 * (1 - i%2))
+        r.Min[i/2] |= lo << shift
+        r.Max[i/2] |= hi << shift
+    }
+    return r, nil
+}
+
+// fromHexChar decodes a single hex digit
+func fromHexChar(c byte) (byte, bool) {
+    switch {
+    case '0' <= c && c <= '9':
+        return c - '0', true
+    case 'a' <= c && c <= 'f':
+        return c - 'a' + 10, true
+    case 'A' <= c && c <= 'F':
+        return c - 'A' + 10, true
+    }
+    return 0, false
+}
+
+// ContainsOrderKey reports whether the OrderKey of u lies within the
+// range, for ranges expressed in OrderKey space
+func (r Range) ContainsOrderKey(u UUID) bool {
+    return r.Contains(u.OrderKey())
+}
@@ -0,0 +1,42 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestMatchPrefixHex(t *testing.T) {
+    r, err := MatchPrefixHex("550E840")
+    require.NoError(t, err)
+    assert.Equal(t, "550e8400-0000-0000-0000-000000000000", r.Min.String())
+    assert.Equal(t, "550e840f-ffff-ffff-ffff-ffffffffffff", r.Max.String())
+    assert.True(t, r.Contains(MustParse("550e8400-e29b-41d4-a716-446655440000")))
+    assert.False(t, r.Contains(MustParse("550e8410-0000-0000-0000-000000000000")))
+
+    r, err = MatchPrefixHex("550e8400-e2")
+    require.NoError(t, err)
+    assert.Equal(t, "550e8400-e200-0000-0000-000000000000", r.Min.String())
+    assert.Equal(t, "550e8400-e2ff-ffff-ffff-ffffffffffff", r.Max.String())
+
+    r, err = MatchPrefixHex("")
+    require.NoError(t, err)
+    assert.Equal(t, Nil, r.Min)
+    assert.Equal(t, maxUUID, r.Max)
+}
+
+func TestMatchPrefixHexFull(t *testing.T) {
+    uuid := New()
+    r, err := MatchPrefixHex(uuid.String())
+    require.NoError(t, err)
+    assert.Equal(t, uuid, r.Min)
+    assert.Equal(t, uuid, r.Max)
+}
+
+func TestMatchPrefixHexInvalid(t *testing.T) {
+    _, err := MatchPrefixHex("55xz")
+    assert.Error(t, err)
+    _, err = MatchPrefixHex("550e8400e29b41d4a7164466554400000")
+    assert.Error(t, err)
+}
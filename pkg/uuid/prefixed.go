@@ -0,0 +1,95 @@
+package uuid
+
+import (
+    "database/sql/driver"
+    "fmt"
+    "strings"
+)
+
+// PrefixedID is a Stripe-style public identifier: an application-defined
+// prefix such as "user_" or "ord_" followed by the base58 form of a UUID,
+// e.g. "user_Nfzd4ZX9ozmHR6C3RrbN6o". The text and JSON forms carry the
+// prefix while the SQL form is the bare UUID, so the database stores the
+// raw value.
+//
+// Decoding into a PrefixedID whose Prefix is already set requires the
+// input to carry that prefix, which lets a struct field reject IDs of the
+// wrong kind. Scan keeps the preset prefix.
+type PrefixedID struct {
+    // Prefix includes its trailing underscore
+    Prefix string
+    UUID   UUID
+}
+
+// NewPrefixedID returns the prefixed form of u. The prefix must be one or
+// more lowercase letters or digits followed by an underscore.
+func NewPrefixedID(prefix string, u UUID) (PrefixedID, error) {
+    if err := validateIDPrefix(prefix); err != nil {
+        return PrefixedID{}, err
+    }
+    return PrefixedID{Prefix: prefix, UUID: u}, nil
+}
+
+// ParsePrefixedID parses a prefixed ID with any valid prefix
+func ParsePrefixedID(s string) (PrefixedID, error) {
+    i := strings.LastIndexByte(s, '_')
+    if i < 0 {
+        return PrefixedID{}, fmt.Errorf("invalid prefixed ID %q: missing prefix", s)
+    }
+    prefix := s[:i+1]
+    if err := validateIDPrefix(prefix); err != nil {
+        return PrefixedID{}, err
+    }
+    u, err := FromBase58(s[i+1:])
+    if err != nil {
+        return PrefixedID{}, fmt.Errorf("invalid prefixed ID %q: %w", s, err)
+    }
+    return PrefixedID{Prefix: prefix, UUID: u}, nil
+}
+
+// String returns the prefix followed by the base58 form of the UUID
+func (p PrefixedID) String() string {
+    return p.Prefix + p.UUID.ToBase58()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (p PrefixedID) MarshalText() ([]byte, error) {
+    return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *PrefixedID) UnmarshalText(text []byte) error {
+    parsed, err := ParsePrefixedID(string(text))
+    if err != nil {
+        return err
+    }
+    if p.Prefix != "" && parsed.Prefix != p.Prefix {
+        return fmt.Errorf("invalid prefixed ID %q: want prefix %q", text, p.Prefix)
+    }
+    *p = parsed
+    return nil
+}
+
+// Value implements driver.Valuer, storing the bare UUID
+func (p PrefixedID) Value() (driver.Value, error) {
+    return p.UUID.Value()
+}
+
+// Scan implements sql.Scanner, reading a bare UUID
+func (p *PrefixedID) Scan(value interface{}) error {
+    return p.UUID.Scan(value)
+}
+
+// validateIDPrefix checks that prefix is lowercase letters or digits
+// followed by a single underscore
+func validateIDPrefix(prefix string) error {
+    if len(prefix) < 2 || prefix[len(prefix)-1] != '_' {
+        return fmt.Errorf("invalid ID prefix %q: must end with an underscore", prefix)
+    }
+    for i := 0; i < len(prefix)-1; i++ {
+        if c := prefix[i]; (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+            return fmt.Errorf("invalid ID prefix %q: invalid character %q", prefix, c)
+        }
+    }
+    return nil
+}
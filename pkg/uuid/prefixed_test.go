@@ -0,0 +1,59 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestPrefixedID(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    id, err := NewPrefixedID("user_", u)
+    require.NoError(t, err)
+    assert.Equal(t, "user_"+u.ToBase58(), id.String())
+
+    parsed, err := ParsePrefixedID(id.String())
+    require.NoError(t, err)
+    assert.Equal(t, id, parsed)
+
+    for _, prefix := range []string{"", "_", "user", "User_", "us-er_", "user__"} {
+        _, err := NewPrefixedID(prefix, u)
+        assert.Error(t, err, prefix)
+    }
+    for _, s := range []string{"", "user", "user_", "user_0OIl", "USER_" + u.ToBase58()} {
+        _, err := ParsePrefixedID(s)
+        assert.Error(t, err, s)
+    }
+}
+
+func TestPrefixedIDJSON(t *testing.T) {
+    type order struct {
+        ID PrefixedID `json:"id"`
+    }
+    id := PrefixedID{Prefix: "ord_", UUID: New()}
+    data, err := json.Marshal(order{ID: id})
+    require.NoError(t, err)
+    assert.JSONEq(t, `{"id":"`+id.String()+`"}`, string(data))
+
+    var back order
+    require.NoError(t, json.Unmarshal(data, &back))
+    assert.Equal(t, id, back.ID)
+
+    // A preset prefix rejects IDs of another kind
+    strict := order{ID: PrefixedID{Prefix: "user_"}}
+    assert.Error(t, json.Unmarshal(data, &strict))
+}
+
+func TestPrefixedIDSQL(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    id := PrefixedID{Prefix: "user_", UUID: u}
+    v, err := id.Value()
+    require.NoError(t, err)
+    assert.Equal(t, u.String(), v)
+
+    scanned := PrefixedID{Prefix: "user_"}
+    require.NoError(t, scanned.Scan(u.String()))
+    assert.Equal(t, id, scanned)
+}
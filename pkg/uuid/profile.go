@@ -0,0 +1,157 @@
+package uuid
+
+import (
+    "encoding/hex"
+    "fmt"
+    "strings"
+)
+
+// maxUUID is the Max UUID, all bits set
+var maxUUID = UUID{
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+}
+
+// Profile bundles the parse, format and validation rules a service needs
+// for interoperability, so they can be declared once and shared by
+// everything that reads, writes or generates UUIDs
+type Profile struct {
+    Name string
+
+    // AllowCompact accepts the 32-digit form without hyphens
+    AllowCompact bool
+    // AllowBraces accepts input wrapped in {}
+    AllowBraces bool
+    // AllowURN accepts a urn:uuid: prefix
+    AllowURN bool
+
+    // Uppercase formats with uppercase hex digits
+    Uppercase bool
+    // Braces formats wrapped in {}
+    Braces bool
+
+    // Variants lists the accepted variants; empty accepts any
+    Variants []Variant
+    // Versions lists the accepted versions; empty accepts any. The Nil and
+    // Max UUIDs are always accepted.
+    Versions []Version
+}
+
+// Built-in profiles
+var (
+    // ProfileStrictRFC9562 accepts only the canonical hyphenated form of
+    // RFC variant UUIDs with a version defined by RFC 9562
+    ProfileStrictRFC9562 = Profile{
+        Name:     "strict-rfc9562",
+        Variants: []Variant{VariantRFC4122},
+        Versions: []Version{
+            VersionTimeBased, VersionDCESecurity, VersionNameBasedMD5, VersionRandom,
+            VersionNameBasedSHA1, VersionReorderedTime, VersionUnixTime, VersionCustom,
+        },
+    }
+
+    // ProfileLenientLegacy accepts the canonical, compact, braced and URN
+    // forms and places no restriction on version or variant
+    ProfileLenientLegacy = Profile{
+        Name:         "lenient-legacy",
+        AllowCompact: true,
+        AllowBraces:  true,
+        AllowURN:     true,
+    }
+
+    // ProfileMicrosoft matches the registry/COM GUID conventions: braced,
+    // uppercase output and the RFC or Microsoft variant
+    ProfileMicrosoft = Profile{
+        Name:        "microsoft",
+        AllowBraces: true,
+        Uppercase:   true,
+        Braces:      true,
+        Variants:    []Variant{VariantRFC4122, VariantMicrosoft},
+    }
+)
+
+// LookupProfile returns the built-in profile with the given name
+func LookupProfile(name string) (Profile, bool) {
+    for _, p := range []Profile{ProfileStrictRFC9562, ProfileLenientLegacy, ProfileMicrosoft} {
+        if p.Name == name {
+            return p, true
+        }
+    }
+    return Profile{}, false
+}
+
+// WithProfile makes a generator check every UUID it produces against p
+func WithProfile(p Profile) Option {
+    return func(g *UUIDGenerator) {
+        g.profile = &p
+    }
+}
+
+// Parse parses s according to the profile's input rules and validates it
+func (p Profile) Parse(s string) (UUID, error) {
+    var uuid UUID
+    if p.AllowURN && len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+        s = s[9:]
+    }
+    if len(s) >= 2 && s[0] == '{' && s[len(s)-1] == '}' {
+        if !p.AllowBraces {
+            return uuid, fmt.Errorf("%w: braces not allowed by profile %s", ErrInvalidFormat, p.Name)
+        }
+        s = s[1 : len(s)-1]
+    }
+
+    var err error
+    switch {
+    case len(s) == EncodedLen:
+        var buf [EncodedLen]byte
+        copy(buf[:], s)
+        uuid, err = ParseFixed(buf)
+    case len(s) == 32 && p.AllowCompact:
+        _, err = hex.Decode(uuid[:], []byte(s))
+        if err != nil {
+            err = fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+        }
+    default:
+        err = fmt.Errorf("%w: %d", ErrInvalidLength, len(s))
+    }
+    if err != nil {
+        return Nil, err
+    }
+    return uuid, p.Validate(uuid)
+}
+
+// Format returns u formatted according to the profile's output rules
+func (p Profile) Format(u UUID) string {
+    l := Layout{Upper: p.Uppercase}
+    if p.Braces {
+        l.Enclosure = EncloseBraces
+    }
+    return u.Format(l)
+}
+
+// Validate checks u against the active deny list and the profile's
+// version and variant rules
+func (p Profile) Validate(u UUID) error {
+    if err := checkDenied(u); err != nil {
+        return err
+    }
+    if u == Nil || u == maxUUID {
+        return nil
+    }
+    if len(p.Variants) > 0 && !containsVariant(p.Variants, u.Variant()) {
+        return fmt.Errorf("%w: %s not allowed by profile %s", ErrInvalidVariant, variantName(u.Variant()), p.Name)
+    }
+    if len(p.Versions) > 0 && !containsVersion(p.Versions, u.Version()) {
+        return fmt.Errorf("%w: %d not allowed by profile %s", ErrInvalidVersion, u.Version(), p.Name)
+    }
+    return nil
+}
+
+func containsVariant(variants []Variant, variant Variant) bool {
+    for _, v := range variants {
+        if v == variant {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,65 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestProfileParse(t *testing.T) {
+    const canonical = "550e8400-e29b-41d4-a716-446655440000"
+    tests := []struct {
+        profile Profile
+        input   string
+        wantErr bool
+    }{
+        {ProfileStrictRFC9562, canonical, false},
+        {ProfileStrictRFC9562, "550e8400e29b41d4a716446655440000", true},
+        {ProfileStrictRFC9562, "{" + canonical + "}", true},
+        {ProfileStrictRFC9562, "550e8400-e29b-01d4-a716-446655440000", true},
+        {ProfileStrictRFC9562, "00000000-0000-0000-0000-000000000000", false},
+        {ProfileLenientLegacy, "urn:uuid:" + canonical, false},
+        {ProfileLenientLegacy, "550e8400e29b41d4a716446655440000", false},
+        {ProfileLenientLegacy, "550e8400-e29b-01d4-0716-446655440000", false},
+        {ProfileMicrosoft, "{550E8400-E29B-41D4-A716-446655440000}", false},
+        {ProfileMicrosoft, "urn:uuid:" + canonical, true},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.profile.Name+"/"+tt.input, func(t *testing.T) {
+            uuid, err := tt.profile.Parse(tt.input)
+            if tt.wantErr {
+                assert.Error(t, err)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, 36, len(uuid.String()))
+        })
+    }
+}
+
+func TestProfileFormat(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", ProfileStrictRFC9562.Format(uuid))
+    assert.Equal(t, "{550E8400-E29B-41D4-A716-446655440000}", ProfileMicrosoft.Format(uuid))
+}
+
+func TestLookupProfile(t *testing.T) {
+    p, ok := LookupProfile("microsoft")
+    assert.True(t, ok)
+    assert.True(t, p.Braces)
+
+    _, ok = LookupProfile("unknown")
+    assert.False(t, ok)
+}
+
+func TestWithProfile(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime, WithProfile(ProfileStrictRFC9562))
+    _, err := gen.Generate()
+    assert.NoError(t, err)
+
+    v4Only := Profile{Name: "v4-only", Versions: []Version{VersionRandom}}
+    _, err = MustNewGenerator(VersionUnixTime, WithProfile(v4Only)).Generate()
+    assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package uuid
+
+// Ptr returns a pointer to a copy of u, for optional pointer fields
+func Ptr(u UUID) *UUID {
+    return &u
+}
+
+// FromPtr returns the UUID p points to and true, or Nil and false if p is
+// nil
+func FromPtr(p *UUID) (UUID, bool) {
+    if p == nil {
+        return Nil, false
+    }
+    return *p, true
+}
+
+// PtrString returns the string form of the UUID p points to, or an empty
+// string if p is nil
+func PtrString(p *UUID) string {
+    if p == nil {
+        return ""
+    }
+    return p.String()
+}
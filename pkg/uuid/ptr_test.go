@@ -0,0 +1,31 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestPtr(t *testing.T) {
+    u := New()
+    p := Ptr(u)
+    assert.Equal(t, u, *p)
+
+    // The pointer refers to a copy
+    p[0] ^= 0xff
+    assert.NotEqual(t, u, *p)
+
+    got, ok := FromPtr(Ptr(u))
+    assert.True(t, ok)
+    assert.Equal(t, u, got)
+
+    got, ok = FromPtr(nil)
+    assert.False(t, ok)
+    assert.Equal(t, Nil, got)
+}
+
+func TestPtrString(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    assert.Equal(t, u.String(), PtrString(&u))
+    assert.Equal(t, "", PtrString(nil))
+}
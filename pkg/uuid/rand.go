@@ -0,0 +1,105 @@
+package uuid
+
+import (
+    "crypto/rand"
+    "io"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// randPoolSize is the number of random bytes fetched per pool refill
+const randPoolSize = 16 * 256
+
+var (
+    // defaultRander is the platform's preferred source of randomness
+    defaultRander io.Reader = rand.Reader
+
+    // rander holds the package-wide source of randomness set by SetRand
+    rander atomic.Value
+
+    // the random pool, see EnableRandPool
+    poolEnabled atomic.Bool
+    poolMu      sync.Mutex
+    poolPos     = randPoolSize
+    pool        [randPoolSize]byte
+)
+
+// SetRand sets the randomness source used by the package-level functions
+// and by generators without their own source. A nil reader restores the
+// platform default, crypto/rand on most targets. SetRand is safe for
+// concurrent use.
+func SetRand(r io.Reader) {
+    rander.Store(randerHolder{r: r})
+}
+
+// randerHolder gives atomic.Value a single concrete type to store
+type randerHolder struct {
+    r io.Reader
+}
+
+// packageRand returns the package-wide source of randomness
+func packageRand() io.Reader {
+    if h, _ := rander.Load().(randerHolder); h.r != nil {
+        return h.r
+    }
+    return defaultRander
+}
+
+// EnableRandPool makes V4 generation that uses the package-wide source
+// draw from a pool of random bytes that is refilled in large chunks,
+// amortizing the cost of reading the source across 256 UUIDs. The pool
+// lives on the heap, so the randomness of UUIDs still to be issued could
+// leak through a memory disclosure; only enable it when throughput
+// matters more.
+func EnableRandPool() {
+    poolEnabled.Store(true)
+}
+
+// DisableRandPool turns the random pool off and wipes its contents
+func DisableRandPool() {
+    poolEnabled.Store(false)
+    poolMu.Lock()
+    poolPos = randPoolSize
+    clear(pool[:])
+    poolMu.Unlock()
+}
+
+// readPool fills b, which must be at most 16 bytes, from the random pool
+func readPool(b []byte) error {
+    poolMu.Lock()
+    defer poolMu.Unlock()
+    if poolPos+len(b) > randPoolSize {
+        if _, err := io.ReadFull(packageRand(), pool[:]); err != nil {
+            return err
+        }
+        poolPos = 0
+    }
+    poolPos += copy(b, pool[poolPos:])
+    return nil
+}
+
+// WithRand makes a generator read randomness from r instead of the
+// package-wide source, e.g. a DRBG, a hardware source or a test reader
+func WithRand(r io.Reader) Option {
+    return func(g *UUIDGenerator) {
+        g.rand = r
+    }
+}
+
+// random returns the generator's randomness source
+func (g *UUIDGenerator) random() io.Reader {
+    if g.rand != nil {
+        return g.rand
+    }
+    return packageRand()
+}
+
+// readRandom fills b from the generator's randomness source
+func (g *UUIDGenerator) readRandom(b []byte) error {
+    if g.latency != nil {
+        defer g.observeSince(StageEntropy, time.Now())
+    }
+    _, err := io.ReadFull(g.random(), b)
+    return err
+}
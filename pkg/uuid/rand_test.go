@@ -0,0 +1,75 @@
+package uuid
+
+import (
+    "bytes"
+    "errors"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+    return 0, errors.New("entropy unavailable")
+}
+
+func TestWithRand(t *testing.T) {
+    src := bytes.Repeat([]byte{0xab}, 32)
+    gen := MustNewGenerator(VersionRandom, WithRand(bytes.NewReader(src)))
+
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, "abababab-abab-4bab-abab-abababababab", uuid.String())
+
+    _, err = MustNewGenerator(VersionUnixTime, WithRand(failingReader{})).Generate()
+    assert.Error(t, err)
+}
+
+func TestSetRand(t *testing.T) {
+    SetRand(bytes.NewReader(make([]byte, 16)))
+    defer SetRand(nil)
+
+    uuid, err := NewV4()
+    require.NoError(t, err)
+    assert.Equal(t, "00000000-0000-4000-8000-000000000000", uuid.String())
+
+    _, err = NewV4()
+    assert.Error(t, err)
+}
+
+func TestRandPool(t *testing.T) {
+    EnableRandPool()
+    defer DisableRandPool()
+
+    seen := make(map[UUID]bool)
+    for i := 0; i < 1000; i++ {
+        uuid, err := NewV4()
+        require.NoError(t, err)
+        assert.Equal(t, VersionRandom, uuid.Version())
+        assert.False(t, seen[uuid])
+        seen[uuid] = true
+    }
+}
+
+func TestRandPoolUsesSource(t *testing.T) {
+    SetRand(bytes.NewReader(make([]byte, randPoolSize)))
+    EnableRandPool()
+    defer func() {
+        DisableRandPool()
+        SetRand(nil)
+    }()
+
+    uuid, err := NewV4()
+    require.NoError(t, err)
+    assert.Equal(t, "00000000-0000-4000-8000-000000000000", uuid.String())
+}
+
+func BenchmarkNewV4RandPool(b *testing.B) {
+    EnableRandPool()
+    defer DisableRandPool()
+    for i := 0; i < b.N; i++ {
+        NewV4()
+    }
+}
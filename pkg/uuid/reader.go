@@ -0,0 +1,38 @@
+package uuid
+
+// Reader is an io.Reader producing an endless stream of concatenated
+// 16-byte UUIDs from a Generator, for tools that consume raw ID streams
+type Reader struct {
+    gen  Generator
+    buf  UUID
+    left int
+}
+
+// NewReader creates a Reader drawing UUIDs from gen, or from a V4
+// generator if gen is nil
+func NewReader(gen Generator) *Reader {
+    if gen == nil {
+        gen = MustNewGenerator(VersionRandom)
+    }
+    return &Reader{gen: gen}
+}
+
+// Read fills p with UUID bytes. A UUID split across calls is continued on
+// the next call, so the stream is always aligned to 16-byte boundaries.
+func (r *Reader) Read(p []byte) (int, error) {
+    n := 0
+    for n < len(p) {
+        if r.left == 0 {
+            uuid, err := r.gen.Generate()
+            if err != nil {
+                return n, err
+            }
+            r.buf = uuid
+            r.left = Size
+        }
+        c := copy(p[n:], r.buf[Size-r.left:])
+        r.left -= c
+        n += c
+    }
+    return n, nil
+}
@@ -0,0 +1,37 @@
+package uuid
+
+import (
+    "io"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+    a, b := New(), New()
+    r := NewReader(&scriptedGenerator{ids: []UUID{a, b}})
+
+    buf := make([]byte, 10)
+    n, err := r.Read(buf)
+    require.NoError(t, err)
+    assert.Equal(t, 10, n)
+    assert.Equal(t, a[:10], buf)
+
+    buf = make([]byte, 22)
+    _, err = io.ReadFull(r, buf)
+    require.NoError(t, err)
+    assert.Equal(t, a[10:], buf[:6])
+    assert.Equal(t, b[:], buf[6:])
+}
+
+func TestReaderDefault(t *testing.T) {
+    buf := make([]byte, 64)
+    _, err := io.ReadFull(NewReader(nil), buf)
+    require.NoError(t, err)
+    for i := 0; i < len(buf); i += Size {
+        uuid, err := ParseBytes(buf[i : i+Size])
+        require.NoError(t, err)
+        assert.Equal(t, VersionRandom, uuid.Version())
+    }
+}
@@ -0,0 +1,84 @@
+package uuid
+
+import (
+    "errors"
+    "time"
+)
+
+const (
+    // maxRegressionStall bounds how long RegressionStall waits for the
+    // clock to catch up before giving up with ErrClockRegression
+    maxRegressionStall = time.Second
+    stallInterval      = time.Millisecond
+)
+
+// ErrClockRegression is returned by time-based generators when the clock
+// went backwards and the regression policy forbids continuing
+var ErrClockRegression = errors.New("clock moved backwards")
+
+// RegressionPolicy selects how time-based generators react when the wall
+// clock goes backwards, e.g. after an NTP step
+type RegressionPolicy int
+
+const (
+    // RegressionBump keeps generating: V1 and V6 bump the clock sequence,
+    // and V7 continues from the last issued timestamp and counter. This is
+    // the default.
+    RegressionBump RegressionPolicy = iota
+    // RegressionStall blocks generation until the clock catches up with
+    // the latest reading, failing with ErrClockRegression if that takes
+    // longer than a second
+    RegressionStall
+    // RegressionError fails generation with ErrClockRegression until the
+    // clock catches up
+    RegressionError
+)
+
+// WithRegressionPolicy sets how a time-based generator handles the clock
+// going backwards
+func WithRegressionPolicy(p RegressionPolicy) Option {
+    return func(g *UUIDGenerator) {
+        g.policy = p
+    }
+}
+
+// readClock reads the clock and applies the regression policy. Callers
+// must hold g.mu; RegressionStall releases it while waiting for the clock.
+func (g *UUIDGenerator) readClock() (time.Time, error) {
+    t := g.now()
+    if !g.observeClock(t) {
+        return t, nil
+    }
+
+    switch g.policy {
+    case RegressionError:
+        return t, ErrClockRegression
+    case RegressionStall:
+        for waited := time.Duration(0); g.observeClock(t); waited += stallInterval {
+            if waited >= maxRegressionStall {
+                return t, ErrClockRegression
+            }
+            g.mu.Unlock()
+            time.Sleep(stallInterval)
+            g.mu.Lock()
+            t = g.now()
+        }
+    }
+    return t, nil
+}
+
+// observeClock records a clock reading, reporting whether it is behind the
+// latest reading seen so far. Callers must hold g.mu.
+func (g *UUIDGenerator) observeClock(t time.Time) bool {
+    if t.Before(g.lastClock) {
+        if !g.regressing {
+            g.regressing = true
+            g.regressions++
+            g.lastRegression = t
+        }
+        return true
+    }
+    g.regressing = false
+    g.lastClock = t
+    return false
+}
@@ -0,0 +1,95 @@
+package uuid
+
+import (
+    "sync/atomic"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func regressingClock() *stepClock {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    return &stepClock{times: []time.Time{
+        base,
+        base.Add(-time.Second),
+        base.Add(-time.Second),
+        base.Add(time.Millisecond),
+    }}
+}
+
+func TestRegressionBump(t *testing.T) {
+    gen := MustNewGenerator(VersionTimeBased, WithClock(regressingClock()))
+    a := Must(gen.Generate())
+    b := Must(gen.Generate())
+    assert.NotEqual(t, a.ClockSequence(), b.ClockSequence())
+
+    gen = MustNewGenerator(VersionUnixTime, WithClock(regressingClock()))
+    a = Must(gen.Generate())
+    assert.Equal(t, 1, Must(gen.Generate()).Compare(a))
+}
+
+func TestRegressionError(t *testing.T) {
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime, VersionUnixTime} {
+        gen := MustNewGenerator(version, WithClock(regressingClock()), WithRegressionPolicy(RegressionError))
+        Must(gen.Generate())
+
+        _, err := gen.Generate()
+        assert.ErrorIs(t, err, ErrClockRegression)
+        _, err = gen.Generate()
+        assert.ErrorIs(t, err, ErrClockRegression)
+
+        _, err = gen.Generate()
+        assert.NoError(t, err)
+        assert.Equal(t, uint64(1), gen.Stats().Regressions)
+    }
+}
+
+func TestRegressionStall(t *testing.T) {
+    gen := MustNewGenerator(VersionReorderedTime, WithClock(regressingClock()), WithRegressionPolicy(RegressionStall))
+    a := Must(gen.Generate())
+    b, err := gen.Generate()
+    require.NoError(t, err)
+
+    ta, _ := a.Time()
+    tb, _ := b.Time()
+    assert.True(t, tb.After(ta))
+    assert.Equal(t, a.ClockSequence(), b.ClockSequence())
+}
+
+func TestRegressionStallGivesUp(t *testing.T) {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    clock := &stepClock{times: []time.Time{base, base.Add(-time.Hour)}}
+    gen := MustNewGenerator(VersionUnixTime, WithClock(clock), WithRegressionPolicy(RegressionStall))
+    Must(gen.Generate())
+
+    _, err := gen.Generate()
+    assert.ErrorIs(t, err, ErrClockRegression)
+}
+
+func TestRegressionStallReleasesLock(t *testing.T) {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    var reads atomic.Int32
+    clock := ClockFunc(func() time.Time {
+        if reads.Add(1) == 1 {
+            return base
+        }
+        return base.Add(-time.Hour)
+    })
+    gen := MustNewGenerator(VersionUnixTime, WithClock(clock), WithRegressionPolicy(RegressionStall))
+    Must(gen.Generate())
+
+    done := make(chan error)
+    go func() {
+        _, err := gen.Generate()
+        done <- err
+    }()
+    require.Eventually(t, func() bool { return reads.Load() > 3 }, time.Second, time.Millisecond)
+
+    // The stalled generation must not block other users of the generator
+    start := time.Now()
+    require.NoError(t, gen.SetNodeID([]byte{1, 2, 3, 4, 5, 6}))
+    assert.Less(t, time.Since(start), maxRegressionStall/2)
+    assert.ErrorIs(t, <-done, ErrClockRegression)
+}
@@ -0,0 +1,25 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestSeeder(t *testing.T) {
+    tenant := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    schema := Schema{"user": 3, "order": 2}
+
+    first := NewSeeder(tenant).Seed(schema)
+    second := NewSeeder(tenant).Seed(schema)
+    assert.Equal(t, first, second)
+
+    require.Len(t, first["user"], 3)
+    require.Len(t, first["order"], 2)
+    assert.Equal(t, NewSeeder(tenant).ID("user", 1), first["user"][1])
+    assert.NotEqual(t, first["user"][0], first["order"][0])
+
+    other := NewSeeder(New()).Seed(schema)
+    assert.NotEqual(t, first["user"][0], other["user"][0])
+}
@@ -0,0 +1,43 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestSeededGeneratorReproducible(t *testing.T) {
+    for _, version := range []Version{VersionRandom, VersionUnixTime, VersionReorderedTime} {
+        a, err := NewSeededGenerator(version, 42)
+        require.NoError(t, err)
+        b, err := NewSeededGenerator(version, 42)
+        require.NoError(t, err)
+        c, err := NewSeededGenerator(version, 43)
+        require.NoError(t, err)
+
+        for i := 0; i < 10; i++ {
+            x, y, z := Must(a.Generate()), Must(b.Generate()), Must(c.Generate())
+            assert.Equal(t, x, y)
+            assert.NotEqual(t, x, z)
+            assert.Equal(t, version, x.Version())
+            assert.Equal(t, VariantRFC4122, x.Variant())
+        }
+    }
+}
+
+func TestSeededGeneratorV7Ordered(t *testing.T) {
+    gen, err := NewSeededGenerator(VersionUnixTime, 1)
+    require.NoError(t, err)
+
+    first := Must(gen.Generate())
+    ts, err := first.Time()
+    require.NoError(t, err)
+    assert.True(t, seededEpoch.Equal(ts))
+    assert.Equal(t, 1, Must(gen.Generate()).Compare(first))
+}
+
+func TestSeededGeneratorUnsupported(t *testing.T) {
+    _, err := NewSeededGenerator(VersionDCESecurity, 1)
+    assert.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestSequentialGenerator(t *testing.T) {
+    gen := NewSequentialGenerator(VersionRandom)
+
+    assert.Equal(t, "00000000-0000-4000-8000-000000000001", Must(gen.Generate()).String())
+    assert.Equal(t, "00000000-0000-4000-8000-000000000002", Must(gen.Generate()).String())
+    assert.Equal(t, VersionRandom, gen.Version())
+
+    gen.Reset()
+    uuid := Must(gen.Generate())
+    assert.Equal(t, "00000000-0000-4000-8000-000000000001", uuid.String())
+    assert.Equal(t, VersionRandom, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+}
+
+func TestSequentialGeneratorVersion(t *testing.T) {
+    gen := NewSequentialGenerator(VersionUnixTime)
+    assert.Equal(t, "00000000-0000-7000-8000-000000000001", Must(gen.Generate()).String())
+}
+
+func TestSequentialGeneratorExhausted(t *testing.T) {
+    gen := NewSequentialGenerator(VersionRandom)
+    gen.n = maxSequence - 1
+    assert.Equal(t, "00000000-0000-4000-8000-ffffffffffff", Must(gen.Generate()).String())
+    _, err := gen.Generate()
+    assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import "fmt"
+
+// ToMixedEndian returns u in the mixed-endian wire layout used by EFI
+// GUIDs and SMBIOS 2.6+ System UUIDs, with the first three groups
+// little-endian. It is the same layout as ToGUIDBytes.
+func (u UUID) ToMixedEndian() []byte {
+    return u.ToGUIDBytes()
+}
+
+// FromMixedEndian decodes 16 bytes in the EFI/SMBIOS mixed-endian layout,
+// the inverse of ToMixedEndian
+func FromMixedEndian(b []byte) (UUID, error) {
+    return FromGUIDBytes(b)
+}
+
+// FromSMBIOS decodes the UUID field of an SMBIOS System Information (type
+// 1) structure as dmidecode does: the field is mixed-endian from SMBIOS
+// 2.6 on and taken as big-endian for earlier versions. The all-zero and
+// all-ones values, which the specification reserves for "not present"
+// and "not settable", are rejected.
+func FromSMBIOS(b []byte, major, minor int) (UUID, error) {
+    if len(b) != Size {
+        return Nil, fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(b))
+    }
+    if isZero(b) {
+        return Nil, fmt.Errorf("SMBIOS UUID not present")
+    }
+    if isAllOnes(b) {
+        return Nil, fmt.Errorf("SMBIOS UUID not settable")
+    }
+    if major > 2 || major == 2 && minor >= 6 {
+        return FromMixedEndian(b)
+    }
+    return ParseBytes(b)
+}
+
+func isAllOnes(b []byte) bool {
+    for _, v := range b {
+        if v != 0xff {
+            return false
+        }
+    }
+    return true
+}
@@ -0,0 +1,45 @@
+package uuid
+
+import (
+    "bytes"
+    "encoding/hex"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestMixedEndian(t *testing.T) {
+    // EFI_GLOBAL_VARIABLE 8be4df61-93ca-11d2-aa0d-00e098032b8c as laid out
+    // in memory
+    raw, _ := hex.DecodeString("61dfe48bca93d211aa0d00e098032b8c")
+    u, err := FromMixedEndian(raw)
+    require.NoError(t, err)
+    assert.Equal(t, "8be4df61-93ca-11d2-aa0d-00e098032b8c", u.String())
+    assert.Equal(t, raw, u.ToMixedEndian())
+
+    _, err = FromMixedEndian(raw[:10])
+    assert.Error(t, err)
+}
+
+func TestFromSMBIOS(t *testing.T) {
+    raw, _ := hex.DecodeString("61dfe48bca93d211aa0d00e098032b8c")
+
+    u, err := FromSMBIOS(raw, 2, 6)
+    require.NoError(t, err)
+    assert.Equal(t, "8be4df61-93ca-11d2-aa0d-00e098032b8c", u.String())
+    u, err = FromSMBIOS(raw, 3, 0)
+    require.NoError(t, err)
+    assert.Equal(t, "8be4df61-93ca-11d2-aa0d-00e098032b8c", u.String())
+
+    u, err = FromSMBIOS(raw, 2, 5)
+    require.NoError(t, err)
+    assert.Equal(t, "61dfe48b-ca93-d211-aa0d-00e098032b8c", u.String())
+
+    _, err = FromSMBIOS(make([]byte, 16), 3, 0)
+    assert.Error(t, err)
+    _, err = FromSMBIOS(bytes.Repeat([]byte{0xff}, 16), 3, 0)
+    assert.Error(t, err)
+    _, err = FromSMBIOS(raw[:4], 3, 0)
+    assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import "fmt"
+
+// NewFromSnowflake embeds a Twitter/Discord-style 64-bit snowflake ID in a
+// V8 UUID. The ID is stored big-endian around the version and variant
+// fields and the remaining bits are zero:
+//
+//	bytes 0-5   id >> 16, the timestamp and the top of the worker ID
+//	byte  6     version 8, low nibble zero
+//	byte  7     id >> 8
+//	byte  8     variant, low 6 bits zero
+//	byte  9     id
+//	bytes 10-15 zero
+//
+// UUIDs built this way sort in the same order as their snowflakes. The
+// sign bit of id must be clear, as in every snowflake scheme.
+func NewFromSnowflake(id int64) (UUID, error) {
+    var uuid UUID
+    if id < 0 {
+        return uuid, fmt.Errorf("invalid snowflake ID: %d", id)
+    }
+    v := uint64(id)
+    for i := 0; i < 6; i++ {
+        uuid[i] = byte(v >> (56 - 8*i))
+    }
+    uuid[6] = 0x80 // Version 8
+    uuid[7] = byte(v >> 8)
+    uuid[8] = 0x80 // Variant RFC4122
+    uuid[9] = byte(v)
+    return uuid, nil
+}
+
+// SnowflakeID extracts the snowflake embedded by NewFromSnowflake. It fails
+// if u is not a V8 UUID with the zero padding of that layout.
+func (u UUID) SnowflakeID() (int64, error) {
+    if u[0]&0x80 != 0 || u[6] != 0x80 || u[8] != 0x80 || !isZero(u[10:]) {
+        return 0, fmt.Errorf("UUID %s does not embed a snowflake ID", u)
+    }
+    var v uint64
+    for i := 0; i < 6; i++ {
+        v = v<<8 | uint64(u[i])
+    }
+    v = v<<16 | uint64(u[7])<<8 | uint64(u[9])
+    return int64(v), nil
+}
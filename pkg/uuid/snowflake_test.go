@@ -0,0 +1,50 @@
+package uuid
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestNewFromSnowflake(t *testing.T) {
+    // A Discord snowflake
+    uuid, err := NewFromSnowflake(175928847299117063)
+    require.NoError(t, err)
+    assert.Equal(t, "0271065a-c102-8000-8007-000000000000", uuid.String())
+    assert.Equal(t, VersionCustom, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+
+    id, err := uuid.SnowflakeID()
+    require.NoError(t, err)
+    assert.Equal(t, int64(175928847299117063), id)
+}
+
+func TestSnowflakeRoundTrip(t *testing.T) {
+    prev := Nil
+    for _, id := range []int64{0, 1, 255, 256, 65535, 65536, 1 << 40, math.MaxInt64} {
+        uuid, err := NewFromSnowflake(id)
+        require.NoError(t, err)
+        back, err := uuid.SnowflakeID()
+        require.NoError(t, err)
+        assert.Equal(t, id, back)
+        assert.Equal(t, 1, uuid.Compare(prev))
+        prev = uuid
+    }
+}
+
+func TestSnowflakeInvalid(t *testing.T) {
+    _, err := NewFromSnowflake(-1)
+    assert.Error(t, err)
+
+    for _, uuid := range []UUID{
+        Nil,
+        MustParse("0271065a-c102-4000-8007-000000000000"),
+        MustParse("0271065a-c102-8000-8007-000000000001"),
+        MustParse("82710e12-d3c0-8000-8007-000000000000"),
+    } {
+        _, err := uuid.SnowflakeID()
+        assert.Error(t, err, uuid.String())
+    }
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import (
+    "encoding/binary"
+    "fmt"
+)
+
+const (
+    // stateFormat is the version of the ExportState blob layout
+    stateFormat = 1
+    stateLen    = 27
+
+    stateNodeSet = 1 << 0
+    stateSeqSet  = 1 << 1
+)
+
+// ExportState captures the generator's node ID, clock sequence and last
+// issued timestamps as a versioned blob. Importing it into a generator on
+// another host lets an allocator be live-migrated without issuing
+// duplicate or regressing time-based UUIDs.
+//
+// The layout is: format (1 byte), generator version (1), flags (1),
+// node ID (6), clock sequence (2), last V1/V6 timestamp (8) and last V7
+// timestamp and counter (8), with integers in big-endian order.
+func (g *UUIDGenerator) ExportState() []byte {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+
+    buf := make([]byte, stateLen)
+    buf[0] = stateFormat
+    buf[1] = byte(g.version)
+    if g.nodeSet {
+        buf[2] |= stateNodeSet
+    }
+    if g.seqSet {
+        buf[2] |= stateSeqSet
+    }
+    copy(buf[3:9], g.node[:])
+    binary.BigEndian.PutUint16(buf[9:11], g.clockSeq)
+    binary.BigEndian.PutUint64(buf[11:19], g.lastTime)
+    binary.BigEndian.PutUint64(buf[19:27], g.lastV7)
+    return buf
+}
+
+// ImportState restores state captured by ExportState from a generator of
+// the same version. Timestamps only move forward: if the generator has
+// already issued later UUIDs than the exported state, those are kept.
+func (g *UUIDGenerator) ImportState(state []byte) error {
+    if len(state) == 0 || state[0] != stateFormat {
+        return fmt.Errorf("unsupported generator state format")
+    }
+    if len(state) != stateLen {
+        return fmt.Errorf("invalid generator state length: %d", len(state))
+    }
+    if Version(state[1]) != g.version {
+        return fmt.Errorf("generator state is for version %d, not %d", state[1], g.version)
+    }
+
+    g.mu.Lock()
+    defer g.mu.Unlock()
+
+    if state[2]&stateNodeSet != 0 {
+        copy(g.node[:], state[3:9])
+        g.nodeSet = true
+    }
+    if state[2]&stateSeqSet != 0 {
+        g.clockSeq = binary.BigEndian.Uint16(state[9:11]) & 0x3fff
+        g.seqSet = true
+    }
+    if last := binary.BigEndian.Uint64(state[11:19]); last > g.lastTime {
+        g.lastTime = last
+    }
+    if last := binary.BigEndian.Uint64(state[19:27]); last > g.lastV7 {
+        g.lastV7 = last
+    }
+    return nil
+}
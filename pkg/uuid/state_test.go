@@ -0,0 +1,46 @@
+package uuid
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestStateRoundTripV6(t *testing.T) {
+    src := MustNewGenerator(VersionReorderedTime, WithRandomNode())
+    last := Must(src.Generate())
+
+    dst := MustNewGenerator(VersionReorderedTime)
+    require.NoError(t, dst.ImportState(src.ExportState()))
+
+    // The destination runs on a clock that is behind the source
+    dst.clock = ClockFunc(func() time.Time { return time.Now().Add(-time.Hour) })
+    next := Must(dst.Generate())
+
+    assert.Equal(t, last.NodeID(), next.NodeID())
+    assert.NotEqual(t, last.ClockSequence(), next.ClockSequence())
+}
+
+func TestStateRoundTripV7(t *testing.T) {
+    src := MustNewGenerator(VersionUnixTime, WithClock(ClockFunc(func() time.Time {
+        return time.Now().Add(time.Hour)
+    })))
+    last := Must(src.Generate())
+
+    dst := MustNewGenerator(VersionUnixTime)
+    require.NoError(t, dst.ImportState(src.ExportState()))
+    assert.Equal(t, 1, Must(dst.Generate()).Compare(last))
+}
+
+func TestImportStateRejects(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime)
+    assert.Error(t, gen.ImportState(nil))
+    assert.Error(t, gen.ImportState([]byte{stateFormat, 7}))
+    assert.Error(t, gen.ImportState(MustNewGenerator(VersionTimeBased).ExportState()))
+
+    state := gen.ExportState()
+    state[0] = 99
+    assert.Error(t, gen.ImportState(state))
+}
@@ -0,0 +1,93 @@
+package uuid
+
+import (
+    "sync"
+    "time"
+)
+
+// Stats reports a generator's issuance accounting
+type Stats struct {
+    // Generated is the number of UUIDs issued since accounting started
+    Generated uint64
+    // Rate is the average number of UUIDs issued per second
+    Rate float64
+    // BurstMax is the most UUIDs issued within a single wall-clock second
+    BurstMax uint64
+    // Regressions counts the times the clock was observed going backwards
+    Regressions uint64
+    // SinceRegression is the time since the clock last went backwards, or
+    // zero if it never has
+    SinceRegression time.Duration
+    // HooksDropped counts UUIDs not passed to hooks because the hook queue
+    // was full or closed
+    HooksDropped uint64
+    // Degraded counts V7 UUIDs issued in degraded mode because the
+    // randomness source failed or stalled, see WithDegradedMode
+    Degraded uint64
+}
+
+// accounting tracks issuance counts for Stats
+type accounting struct {
+    mu        sync.Mutex
+    start     time.Time
+    generated uint64
+    second    int64
+    burst     uint64
+    burstMax  uint64
+}
+
+// WithStats enables issuance accounting, queryable with Stats, at the cost
+// of a lock and a clock read per generated UUID
+func WithStats() Option {
+    return func(g *UUIDGenerator) {
+        g.stats = &accounting{}
+    }
+}
+
+func (a *accounting) record(now time.Time) {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+
+    if a.generated == 0 {
+        a.start = now
+    }
+    a.generated++
+
+    if sec := now.Unix(); sec != a.second {
+        a.second = sec
+        a.burst = 0
+    }
+    a.burst++
+    if a.burst > a.burstMax {
+        a.burstMax = a.burst
+    }
+}
+
+// Stats returns the generator's issuance accounting. Counts and rates are
+// only tracked when the generator was created with WithStats; clock
+// regressions are always tracked for time-based versions.
+func (g *UUIDGenerator) Stats() Stats {
+    now := g.now()
+
+    g.mu.Lock()
+    s := Stats{Regressions: g.regressions, Degraded: g.degraded}
+    if !g.lastRegression.IsZero() {
+        s.SinceRegression = now.Sub(g.lastRegression)
+    }
+    g.mu.Unlock()
+
+    if g.hooks != nil {
+        s.HooksDropped = g.hooks.dropped.Load()
+    }
+
+    if g.stats != nil {
+        g.stats.mu.Lock()
+        s.Generated = g.stats.generated
+        s.BurstMax = g.stats.burstMax
+        if elapsed := now.Sub(g.stats.start).Seconds(); elapsed > 0 {
+            s.Rate = float64(s.Generated) / elapsed
+        }
+        g.stats.mu.Unlock()
+    }
+    return s
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// stepClock is a virtual clock returning a scripted sequence of times
+type stepClock struct {
+    times []time.Time
+    pos   int
+}
+
+func (c *stepClock) Now() time.Time {
+    t := c.times[c.pos]
+    if c.pos < len(c.times)-1 {
+        c.pos++
+    }
+    return t
+}
+
+func TestStats(t *testing.T) {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    // V4 generation reads the clock only for accounting
+    clock := &stepClock{times: []time.Time{
+        base,
+        base.Add(100 * time.Millisecond),
+        base.Add(time.Second),
+        base.Add(2 * time.Second),
+    }}
+    gen := MustNewGenerator(VersionRandom, WithClock(clock), WithStats())
+
+    for i := 0; i < 3; i++ {
+        Must(gen.Generate())
+    }
+
+    stats := gen.Stats()
+    assert.Equal(t, uint64(3), stats.Generated)
+    assert.Equal(t, uint64(2), stats.BurstMax)
+    assert.Equal(t, 1.5, stats.Rate)
+    assert.Zero(t, stats.Regressions)
+    assert.Zero(t, stats.SinceRegression)
+}
+
+func TestStatsRegression(t *testing.T) {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    clock := &stepClock{times: []time.Time{
+        base,
+        base.Add(-time.Second),
+        base.Add(time.Minute),
+    }}
+    gen := MustNewGenerator(VersionUnixTime, WithClock(clock))
+
+    Must(gen.Generate())
+    Must(gen.Generate())
+
+    stats := gen.Stats()
+    assert.Equal(t, uint64(1), stats.Regressions)
+    assert.Equal(t, time.Minute+time.Second, stats.SinceRegression)
+    assert.Zero(t, stats.Generated)
+}
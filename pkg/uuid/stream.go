@@ -0,0 +1,105 @@
+package uuid
+
+import (
+    "context"
+    "errors"
+    "sync"
+)
+
+// Stream continuously generates UUIDs from gen in a background goroutine
+// and delivers them on the returned channel, which holds up to buffer
+// pending values. The goroutine exits and closes the channel when ctx is
+// cancelled or generation fails.
+func Stream(ctx context.Context, gen Generator, buffer int) <-chan UUID {
+    ch := make(chan UUID, buffer)
+    go produce(ctx, gen, ch)
+    return ch
+}
+
+// Stream continuously generates UUIDs in a background goroutine until ctx
+// is cancelled, see the package-level Stream
+func (g *UUIDGenerator) Stream(ctx context.Context, buffer int) <-chan UUID {
+    return Stream(ctx, g, buffer)
+}
+
+// produce sends UUIDs from gen to ch until ctx is cancelled or generation
+// fails, then closes ch
+func produce(ctx context.Context, gen Generator, ch chan<- UUID) {
+    defer close(ch)
+    for {
+        uuid, err := gen.Generate()
+        if err != nil {
+            return
+        }
+        select {
+        case ch <- uuid:
+        case <-ctx.Done():
+            return
+        }
+    }
+}
+
+// errStarted is returned when starting a component twice
+var errStarted = errors.New("already started")
+
+// Producer is a Stream with an explicit lifecycle: Close stops the
+// background goroutine and waits for it to exit, so embedding services can
+// shut down without leaking it
+type Producer struct {
+    gen    Generator
+    ch     chan UUID
+    mu     sync.Mutex
+    cancel context.CancelFunc
+    done   chan struct{}
+    closed bool
+}
+
+// NewProducer creates a producer of UUIDs from gen whose channel holds up
+// to buffer pending values. Call Start to begin generating.
+func NewProducer(gen Generator, buffer int) *Producer {
+    return &Producer{gen: gen, ch: make(chan UUID, buffer)}
+}
+
+// Start launches the background goroutine, which runs until ctx is
+// cancelled, Close is called or generation fails
+func (p *Producer) Start(ctx context.Context) error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    if p.closed {
+        return errClosed
+    }
+    if p.done != nil {
+        return errStarted
+    }
+    ctx, p.cancel = context.WithCancel(ctx)
+    p.done = make(chan struct{})
+    go func() {
+        defer close(p.done)
+        produce(ctx, p.gen, p.ch)
+    }()
+    return nil
+}
+
+// C returns the channel UUIDs are delivered on. It is closed once the
+// producer stops; values buffered before that can still be received.
+func (p *Producer) C() <-chan UUID {
+    return p.ch
+}
+
+// Close stops the producer and waits for its goroutine to exit
+func (p *Producer) Close() error {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    if p.closed {
+        return nil
+    }
+    p.closed = true
+    if p.done == nil {
+        // Never started: close the channel so receivers do not block
+        close(p.ch)
+        return nil
+    }
+    p.cancel()
+    <-p.done
+    return nil
+}
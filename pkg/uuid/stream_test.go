@@ -0,0 +1,78 @@
+package uuid
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    ch := MustNewGenerator(VersionUnixTime).Stream(ctx, 4)
+
+    prev := <-ch
+    for i := 0; i < 100; i++ {
+        next := <-ch
+        assert.Equal(t, 1, next.Compare(prev))
+        prev = next
+    }
+
+    cancel()
+    deadline := time.After(time.Second)
+    for {
+        select {
+        case _, ok := <-ch:
+            if !ok {
+                return
+            }
+        case <-deadline:
+            t.Fatal("stream not closed after cancel")
+        }
+    }
+}
+
+func TestStreamStopsOnError(t *testing.T) {
+    ch := Stream(context.Background(), MustNewGenerator(VersionRandom, WithRand(failingReader{})), 0)
+    _, ok := <-ch
+    assert.False(t, ok)
+}
+
+func TestProducer(t *testing.T) {
+    p := NewProducer(MustNewGenerator(VersionUnixTime), 4)
+    require.NoError(t, p.Start(context.Background()))
+    assert.Error(t, p.Start(context.Background()))
+
+    prev := <-p.C()
+    for i := 0; i < 100; i++ {
+        next := <-p.C()
+        assert.Equal(t, 1, next.Compare(prev))
+        prev = next
+    }
+
+    require.NoError(t, p.Close())
+    require.NoError(t, p.Close())
+    for range p.C() {
+        // Buffered values remain readable until the channel is drained
+    }
+    assert.Error(t, p.Start(context.Background()))
+}
+
+func TestProducerContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    p := NewProducer(MustNewGenerator(VersionRandom), 0)
+    require.NoError(t, p.Start(ctx))
+    cancel()
+    for range p.C() {
+    }
+    require.NoError(t, p.Close())
+}
+
+func TestProducerNeverStarted(t *testing.T) {
+    p := NewProducer(MustNewGenerator(VersionRandom), 1)
+    require.NoError(t, p.Close())
+    _, ok := <-p.C()
+    assert.False(t, ok)
+}
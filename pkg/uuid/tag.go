@@ -0,0 +1,271 @@
+package uuid
+
+import (
+    "encoding/json"
+    "fmt"
+    "reflect"
+    "strconv"
+    "strings"
+    "sync"
+)
+
+// TagOptions is the parsed form of a `uuid:"..."` struct tag, a
+// comma-separated list of key=value pairs:
+//
+//    format   canonical (default), hex, base58 or ulid
+//    version  the only version accepted, e.g. 7
+//
+// For example `uuid:"format=base58,version=7"`.
+type TagOptions struct {
+    Format  Format
+    Version Version
+}
+
+// ParseTag parses the value of a uuid struct tag
+func ParseTag(tag string) (TagOptions, error) {
+    var o TagOptions
+    for _, part := range strings.Split(tag, ",") {
+        if part = strings.TrimSpace(part); part == "" {
+            continue
+        }
+        key, value, _ := strings.Cut(part, "=")
+        switch key {
+        case "format":
+            f, err := ParseFormat(value)
+            if err != nil {
+                return o, err
+            }
+            if f == FormatBinary {
+                return o, fmt.Errorf("format %s has no text form", f)
+            }
+            o.Format = f
+        case "version":
+            v, err := strconv.ParseUint(value, 10, 4)
+            if err != nil || v == 0 {
+                return o, fmt.Errorf("invalid version in uuid tag: %q", value)
+            }
+            o.Version = Version(v)
+        default:
+            return o, fmt.Errorf("unknown uuid tag key: %q", key)
+        }
+    }
+    return o, nil
+}
+
+// Encode formats u according to the options. The Nil UUID is exempt from
+// the version check.
+func (o TagOptions) Encode(u UUID) (string, error) {
+    if err := o.check(u); err != nil {
+        return "", err
+    }
+    return encodeFormat(u, o.Format), nil
+}
+
+// Decode parses s according to the options
+func (o TagOptions) Decode(s string) (UUID, error) {
+    u, err := decodeFormat(s, o.Format)
+    if err != nil {
+        return Nil, err
+    }
+    return u, o.check(u)
+}
+
+func (o TagOptions) check(u UUID) error {
+    if o.Version != 0 && u != Nil && u.Version() != o.Version {
+        return fmt.Errorf("%w: %d does not match tagged version %d", ErrInvalidVersion, u.Version(), o.Version)
+    }
+    return nil
+}
+
+// MarshalTaggedJSON is like json.Marshal, but UUID and *UUID fields of
+// the struct v (or a pointer to it) are encoded according to their uuid
+// struct tags, so fields of one struct can use different
+// representations. Only fields of v itself are interpreted; nested
+// structs can be wrapped in Tagged.
+func MarshalTaggedJSON(v any) ([]byte, error) {
+    rv := reflect.Indirect(reflect.ValueOf(v))
+    if rv.Kind() != reflect.Struct {
+        return json.Marshal(v)
+    }
+    plan, err := tagPlanFor(rv.Type())
+    if err != nil {
+        return nil, err
+    }
+    if plan == nil {
+        return json.Marshal(v)
+    }
+
+    sv := reflect.New(plan.enc).Elem()
+    for i, f := range plan.fields {
+        src := rv.Field(f.index)
+        if f.opts == nil {
+            sv.Field(i).Set(src)
+            continue
+        }
+        if f.ptr {
+            if src.IsNil() {
+                continue
+            }
+            src = src.Elem()
+        }
+        s, err := f.opts.Encode(src.Interface().(UUID))
+        if err != nil {
+            return nil, fmt.Errorf("field %s: %w", f.name, err)
+        }
+        if f.ptr {
+            sv.Field(i).Set(reflect.ValueOf(&s))
+        } else {
+            sv.Field(i).SetString(s)
+        }
+    }
+    return json.Marshal(sv.Interface())
+}
+
+// UnmarshalTaggedJSON is like json.Unmarshal into a pointer to a struct,
+// but decodes UUID and *UUID fields according to their uuid struct tags,
+// see MarshalTaggedJSON
+func UnmarshalTaggedJSON(data []byte, v any) error {
+    rv := reflect.ValueOf(v)
+    if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+        return json.Unmarshal(data, v)
+    }
+    rv = rv.Elem()
+    plan, err := tagPlanFor(rv.Type())
+    if err != nil {
+        return err
+    }
+    if plan == nil {
+        return json.Unmarshal(data, v)
+    }
+
+    // Start from the current values so fields missing from data are kept
+    sv := reflect.New(plan.dec).Elem()
+    for i, f := range plan.fields {
+        if f.opts == nil {
+            sv.Field(i).Set(rv.Field(f.index))
+        }
+    }
+    if err := json.Unmarshal(data, sv.Addr().Interface()); err != nil {
+        return err
+    }
+
+    for i, f := range plan.fields {
+        dst := rv.Field(f.index)
+        if f.opts == nil {
+            dst.Set(sv.Field(i))
+            continue
+        }
+        raw := sv.Field(i).Interface().(json.RawMessage)
+        if raw == nil {
+            continue
+        }
+        if string(raw) == "null" {
+            if f.ptr {
+                dst.SetZero()
+            }
+            continue
+        }
+        var s string
+        if err := json.Unmarshal(raw, &s); err != nil {
+            return fmt.Errorf("field %s: %v", f.name, err)
+        }
+        u, err := f.opts.Decode(s)
+        if err != nil {
+            return fmt.Errorf("field %s: %w", f.name, err)
+        }
+        if f.ptr {
+            dst.Set(reflect.ValueOf(&u))
+        } else {
+            dst.Set(reflect.ValueOf(u))
+        }
+    }
+    return nil
+}
+
+// Tagged wraps a struct so that encoding/json honours its uuid struct
+// tags, including when it is nested in other values:
+//
+//    json.Marshal(uuid.Tagged[Order]{V: order})
+type Tagged[T any] struct {
+    V T
+}
+
+// MarshalJSON implements json.Marshaler
+func (t Tagged[T]) MarshalJSON() ([]byte, error) {
+    return MarshalTaggedJSON(&t.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *Tagged[T]) UnmarshalJSON(data []byte) error {
+    return UnmarshalTaggedJSON(data, &t.V)
+}
+
+// tagPlan describes how to map a struct with uuid tags to the shadow
+// struct types handed to encoding/json
+type tagPlan struct {
+    enc, dec reflect.Type
+    fields   []tagField
+}
+
+type tagField struct {
+    index int
+    name  string
+    ptr   bool
+    opts  *TagOptions
+}
+
+var (
+    tagPlans   sync.Map // reflect.Type -> *tagPlan
+    uuidPtrTyp = reflect.TypeOf((*UUID)(nil))
+    uuidTyp    = uuidPtrTyp.Elem()
+    stringTyp  = reflect.TypeOf("")
+    rawTyp     = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// tagPlanFor returns the plan for struct type t, or nil if no field has a
+// uuid tag
+func tagPlanFor(t reflect.Type) (plan *tagPlan, err error) {
+    if p, ok := tagPlans.Load(t); ok {
+        return p.(*tagPlan), nil
+    }
+
+    var enc, dec []reflect.StructField
+    plan = &tagPlan{}
+    tagged := false
+    for i := 0; i < t.NumField(); i++ {
+        sf := t.Field(i)
+        if !sf.IsExported() {
+            continue
+        }
+        f := tagField{index: i, name: sf.Name}
+        encField, decField := sf, sf
+        encField.Index, decField.Index = nil, nil
+        if tag, ok := sf.Tag.Lookup("uuid"); ok && (sf.Type == uuidTyp || sf.Type == uuidPtrTyp) {
+            opts, err := ParseTag(tag)
+            if err != nil {
+                return nil, fmt.Errorf("field %s: %v", sf.Name, err)
+            }
+            f.opts, f.ptr, tagged = &opts, sf.Type == uuidPtrTyp, true
+            encField.Type, decField.Type = stringTyp, rawTyp
+            if f.ptr {
+                encField.Type = reflect.PointerTo(stringTyp)
+            }
+        }
+        plan.fields = append(plan.fields, f)
+        enc = append(enc, encField)
+        dec = append(dec, decField)
+    }
+    if !tagged {
+        plan = nil
+    } else {
+        defer func() {
+            // StructOf rejects some embedded fields
+            if r := recover(); r != nil {
+                plan, err = nil, fmt.Errorf("unsupported struct type %s: %v", t, r)
+            }
+        }()
+        plan.enc, plan.dec = reflect.StructOf(enc), reflect.StructOf(dec)
+    }
+    tagPlans.Store(t, plan)
+    return plan, nil
+}
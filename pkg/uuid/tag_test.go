@@ -0,0 +1,132 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+type taggedOrder struct {
+    ID       UUID   `json:"id" uuid:"format=base58,version=7"`
+    Customer UUID   `json:"customer"`
+    Trace    UUID   `json:"trace" uuid:"format=hex"`
+    Parent   *UUID  `json:"parent,omitempty" uuid:"format=ulid"`
+    Note     string `json:"note"`
+    internal int
+}
+
+func TestParseTag(t *testing.T) {
+    o, err := ParseTag("format=base58, version=7")
+    require.NoError(t, err)
+    assert.Equal(t, TagOptions{Format: FormatBase58, Version: VersionUnixTime}, o)
+
+    o, err = ParseTag("")
+    require.NoError(t, err)
+    assert.Equal(t, TagOptions{}, o)
+
+    for _, bad := range []string{"format=binary", "format=morse", "version=0", "version=16", "color=red"} {
+        _, err := ParseTag(bad)
+        assert.Error(t, err, bad)
+    }
+}
+
+func TestTagOptions(t *testing.T) {
+    v7 := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+    o := TagOptions{Format: FormatBase58, Version: VersionUnixTime}
+    s, err := o.Encode(v7)
+    require.NoError(t, err)
+    assert.Equal(t, v7.ToBase58(), s)
+    u, err := o.Decode(s)
+    require.NoError(t, err)
+    assert.Equal(t, v7, u)
+
+    _, err = o.Encode(NamespaceDNS)
+    assert.Error(t, err)
+    _, err = o.Decode(NamespaceDNS.ToBase58())
+    assert.Error(t, err)
+    s, err = o.Encode(Nil)
+    require.NoError(t, err)
+    assert.Equal(t, Nil.ToBase58(), s)
+}
+
+func TestTaggedJSON(t *testing.T) {
+    v7 := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+    parent := NamespaceURL
+    order := taggedOrder{ID: v7, Customer: NamespaceDNS, Trace: NamespaceDNS, Parent: &parent, Note: "hi", internal: 1}
+
+    data, err := MarshalTaggedJSON(order)
+    require.NoError(t, err)
+    assert.JSONEq(t, `{
+        "id": "`+v7.ToBase58()+`",
+        "customer": "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+        "trace": "6ba7b8109dad11d180b400c04fd430c8",
+        "parent": "`+parent.ToULID()+`",
+        "note": "hi"
+    }`, string(data))
+
+    decoded := taggedOrder{internal: 2}
+    require.NoError(t, UnmarshalTaggedJSON(data, &decoded))
+    order.internal = 2
+    assert.Equal(t, order, decoded)
+
+    // Absent fields are left alone and null clears pointers
+    require.NoError(t, UnmarshalTaggedJSON([]byte(`{"note":"bye","parent":null}`), &decoded))
+    assert.Equal(t, v7, decoded.ID)
+    assert.Equal(t, "bye", decoded.Note)
+    assert.Nil(t, decoded.Parent)
+
+    data, err = MarshalTaggedJSON(&decoded)
+    require.NoError(t, err)
+    assert.NotContains(t, string(data), "parent")
+
+    // The version constraint applies both ways
+    _, err = MarshalTaggedJSON(taggedOrder{ID: NamespaceDNS})
+    assert.Error(t, err)
+    err = UnmarshalTaggedJSON([]byte(`{"id":"`+NamespaceDNS.ToBase58()+`"}`), &decoded)
+    assert.Error(t, err)
+    err = UnmarshalTaggedJSON([]byte(`{"trace":42}`), &decoded)
+    assert.Error(t, err)
+}
+
+func TestTaggedJSONPassThrough(t *testing.T) {
+    type plain struct {
+        ID UUID `json:"id"`
+    }
+    p := plain{ID: NamespaceDNS}
+    data, err := MarshalTaggedJSON(p)
+    require.NoError(t, err)
+    want, _ := json.Marshal(p)
+    assert.Equal(t, want, data)
+
+    var decoded plain
+    require.NoError(t, UnmarshalTaggedJSON(data, &decoded))
+    assert.Equal(t, p, decoded)
+
+    data, err = MarshalTaggedJSON([]int{1})
+    require.NoError(t, err)
+    assert.Equal(t, "[1]", string(data))
+
+    type badTag struct {
+        ID UUID `uuid:"format=morse"`
+    }
+    _, err = MarshalTaggedJSON(badTag{})
+    assert.Error(t, err)
+}
+
+func TestTaggedWrapper(t *testing.T) {
+    type envelope struct {
+        Order Tagged[taggedOrder] `json:"order"`
+    }
+    v7 := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+    e := envelope{Order: Tagged[taggedOrder]{V: taggedOrder{ID: v7}}}
+
+    data, err := json.Marshal(e)
+    require.NoError(t, err)
+    assert.Contains(t, string(data), `"id":"`+v7.ToBase58()+`"`)
+
+    var decoded envelope
+    require.NoError(t, json.Unmarshal(data, &decoded))
+    assert.Equal(t, v7, decoded.Order.V.ID)
+}
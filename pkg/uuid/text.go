@@ -0,0 +1,33 @@
+package uuid
+
+import "sync/atomic"
+
+// uppercaseText is the package-wide case of MarshalText and MarshalJSON
+var uppercaseText atomic.Bool
+
+// SetUppercaseText makes MarshalText and MarshalJSON emit uppercase hex
+// digits for the whole program, for legacy systems such as SAP and some
+// SOAP APIs that require them. Parsing accepts either case regardless of
+// this setting. SetUppercaseText is safe for concurrent use.
+func SetUppercaseText(upper bool) {
+    uppercaseText.Store(upper)
+}
+
+// UpperString returns the canonical form of the UUID with uppercase hex
+// digits
+func (u UUID) UpperString() string {
+    var buf [EncodedLen]byte
+    encodeCanonical(buf[:], u)
+    upperHex(buf[:])
+    return string(buf[:])
+}
+
+// AppendText implements encoding.TextAppender, appending the form written
+// by MarshalText to b
+func (u UUID) AppendText(b []byte) ([]byte, error) {
+    b = u.AppendString(b)
+    if uppercaseText.Load() {
+        upperHex(b[len(b)-EncodedLen:])
+    }
+    return b, nil
+}
@@ -0,0 +1,80 @@
+package uuid
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestUpperString(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    assert.Equal(t, "550E8400-E29B-41D4-A716-446655440000", uuid.UpperString())
+    assert.Equal(t, uuid, MustParse(uuid.UpperString()))
+
+    allocs := testing.AllocsPerRun(100, func() {
+        _ = uuid.UpperString()
+    })
+    assert.Equal(t, float64(1), allocs)
+}
+
+func TestSetUppercaseText(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    SetUppercaseText(true)
+    defer SetUppercaseText(false)
+
+    text, err := uuid.MarshalText()
+    require.NoError(t, err)
+    assert.Equal(t, "550E8400-E29B-41D4-A716-446655440000", string(text))
+
+    data, err := json.Marshal(uuid)
+    require.NoError(t, err)
+    assert.Equal(t, `"550E8400-E29B-41D4-A716-446655440000"`, string(data))
+    assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", uuid.String())
+
+    var parsed UUID
+    require.NoError(t, parsed.UnmarshalText([]byte(uuid.String())))
+    assert.Equal(t, uuid, parsed)
+
+    text, err = uuid.AppendText([]byte("id="))
+    require.NoError(t, err)
+    assert.Equal(t, "id=550E8400-E29B-41D4-A716-446655440000", string(text))
+
+    cached := NewCachedUUID(uuid)
+    text, err = cached.MarshalText()
+    require.NoError(t, err)
+    assert.Equal(t, "550E8400-E29B-41D4-A716-446655440000", string(text))
+    data, err = json.Marshal(cached)
+    require.NoError(t, err)
+    assert.Equal(t, `"550E8400-E29B-41D4-A716-446655440000"`, string(data))
+
+    SetUppercaseText(false)
+    text, err = uuid.MarshalText()
+    require.NoError(t, err)
+    assert.Equal(t, uuid.String(), string(text))
+}
+
+func TestAppendText(t *testing.T) {
+    // encoding.TextAppender and encoding.BinaryAppender, new in Go 1.24
+    var _ interface {
+        AppendText([]byte) ([]byte, error)
+        AppendBinary([]byte) ([]byte, error)
+    } = UUID{}
+
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    b, err := uuid.AppendText([]byte("id="))
+    require.NoError(t, err)
+    assert.Equal(t, "id=550e8400-e29b-41d4-a716-446655440000", string(b))
+
+    b, err = uuid.AppendBinary([]byte{0xff})
+    require.NoError(t, err)
+    assert.Equal(t, append([]byte{0xff}, uuid[:]...), b)
+
+    buf := make([]byte, 0, 64)
+    allocs := testing.AllocsPerRun(100, func() {
+        buf, _ = uuid.AppendText(buf[:0])
+        buf, _ = uuid.AppendBinary(buf)
+    })
+    assert.Zero(t, allocs)
+}
@@ -0,0 +1,167 @@
+package uuid
+
+import (
+    "fmt"
+    "time"
+)
+
+// gregorianOffset is the number of 100-nanosecond intervals between the
+// start of the Gregorian calendar (1582-10-15) and the Unix epoch
+const gregorianOffset = 122192928000000000
+
+// defaultTimeGenerator backs the package-level NewV1 and NewV6 functions
+var defaultTimeGenerator = &UUIDGenerator{version: VersionTimeBased}
+
+// timeFields returns the timestamp, clock sequence and node for the next
+// time-based UUID, initializing the node and clock sequence on first use
+func (g *UUIDGenerator) timeFields() (uint64, uint16, [6]byte, error) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+
+    if !g.nodeSet {
+        if err := g.initNode(); err != nil {
+            return 0, 0, g.node, err
+        }
+    }
+
+    if !g.seqSet {
+        if err := g.reseed(); err != nil {
+            return 0, 0, g.node, err
+        }
+    }
+
+    t, err := g.readClock()
+    if err != nil {
+        return 0, 0, g.node, err
+    }
+    now := uint64(t.UnixNano()/100) + gregorianOffset
+
+    // Bump the clock sequence if the clock did not advance so that two
+    // calls within the same tick never yield the same UUID
+    if now <= g.lastTime {
+        g.clockSeq = (g.clockSeq + 1) & 0x3fff
+    }
+    g.lastTime = now
+
+    return now, g.clockSeq, g.node, nil
+}
+
+func (g *UUIDGenerator) generateV1() (UUID, error) {
+    var uuid UUID
+    now, seq, node, err := g.timeFields()
+    if err != nil {
+        return uuid, err
+    }
+
+    putV1Time(&uuid, now)
+    putClockSeqAndNode(&uuid, seq, node)
+    return uuid, nil
+}
+
+func (g *UUIDGenerator) generateV6() (UUID, error) {
+    var uuid UUID
+    now, seq, node, err := g.timeFields()
+    if err != nil {
+        return uuid, err
+    }
+
+    putV6Time(&uuid, now)
+    putClockSeqAndNode(&uuid, seq, node)
+    return uuid, nil
+}
+
+// putV1Time writes a 60-bit timestamp and the version in the V1 layout
+func putV1Time(uuid *UUID, ts uint64) {
+    // Time low
+    uuid[0] = byte(ts >> 24)
+    uuid[1] = byte(ts >> 16)
+    uuid[2] = byte(ts >> 8)
+    uuid[3] = byte(ts)
+
+    // Time mid
+    uuid[4] = byte(ts >> 40)
+    uuid[5] = byte(ts >> 32)
+
+    // Time high and version
+    uuid[6] = byte(ts>>56)&0x0f | 0x10 // Version 1
+    uuid[7] = byte(ts >> 48)
+}
+
+// putV6Time writes a 60-bit timestamp and the version in the V6 layout
+func putV6Time(uuid *UUID, ts uint64) {
+    // Time high
+    uuid[0] = byte(ts >> 52)
+    uuid[1] = byte(ts >> 44)
+    uuid[2] = byte(ts >> 36)
+    uuid[3] = byte(ts >> 28)
+
+    // Time mid
+    uuid[4] = byte(ts >> 20)
+    uuid[5] = byte(ts >> 12)
+
+    // Version and time low
+    uuid[6] = byte(ts>>8)&0x0f | 0x60 // Version 6
+    uuid[7] = byte(ts)
+}
+
+// v1Time reads the 60-bit timestamp of a V1 UUID
+func v1Time(u UUID) uint64 {
+    return uint64(u[6]&0x0f)<<56 | uint64(u[7])<<48 |
+        uint64(u[4])<<40 | uint64(u[5])<<32 |
+        uint64(u[0])<<24 | uint64(u[1])<<16 | uint64(u[2])<<8 | uint64(u[3])
+}
+
+// v6Time reads the 60-bit timestamp of a V6 UUID
+func v6Time(u UUID) uint64 {
+    return uint64(u[0])<<52 | uint64(u[1])<<44 | uint64(u[2])<<36 | uint64(u[3])<<28 |
+        uint64(u[4])<<20 | uint64(u[5])<<12 |
+        uint64(u[6]&0x0f)<<8 | uint64(u[7])
+}
+
+func putClockSeqAndNode(uuid *UUID, seq uint16, node [6]byte) {
+    uuid[8] = byte(seq>>8)&0x3f | 0x80 // Variant RFC4122
+    uuid[9] = byte(seq)
+    copy(uuid[10:], node[:])
+}
+
+// Time returns the timestamp embedded in a V1, V6 or V7 UUID
+func (u UUID) Time() (time.Time, error) {
+    switch u.Version() {
+    case VersionTimeBased:
+        return gregorianTime(v1Time(u)), nil
+    case VersionReorderedTime:
+        return gregorianTime(v6Time(u)), nil
+    case VersionUnixTime:
+        ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 |
+            int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+        return time.UnixMilli(ms), nil
+    default:
+        return time.Time{}, fmt.Errorf("%w: %d has no timestamp", ErrInvalidVersion, u.Version())
+    }
+}
+
+// ToV6 converts a V1 UUID to V6 by reordering its timestamp fields. The
+// clock sequence and node are kept, so ToV1 restores the original exactly.
+func (u UUID) ToV6() (UUID, error) {
+    if u.Version() != VersionTimeBased {
+        return Nil, fmt.Errorf("%w: cannot convert version %d to version 6", ErrInvalidVersion, u.Version())
+    }
+    putV6Time(&u, v1Time(u))
+    return u, nil
+}
+
+// ToV1 converts a V6 UUID back to V1, the inverse of ToV6
+func (u UUID) ToV1() (UUID, error) {
+    if u.Version() != VersionReorderedTime {
+        return Nil, fmt.Errorf("%w: cannot convert version %d to version 1", ErrInvalidVersion, u.Version())
+    }
+    putV1Time(&u, v6Time(u))
+    return u, nil
+}
+
+// gregorianTime converts a count of 100-nanosecond intervals since the
+// Gregorian epoch to a time.Time
+func gregorianTime(ts uint64) time.Time {
+    unix100 := int64(ts) - gregorianOffset
+    return time.Unix(unix100/1e7, unix100%1e7*100)
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestNewV6(t *testing.T) {
+    uuid, err := NewV6()
+    require.NoError(t, err)
+    assert.Equal(t, VersionReorderedTime, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+}
+
+func TestV6Ordering(t *testing.T) {
+    gen := MustNewGenerator(VersionReorderedTime)
+    prev, err := gen.Generate()
+    require.NoError(t, err)
+
+    for i := 0; i < 100; i++ {
+        next, err := gen.Generate()
+        require.NoError(t, err)
+        assert.NotEqual(t, prev, next)
+        prev = next
+    }
+}
+
+func TestTimeBasedUnique(t *testing.T) {
+    gen := MustNewGenerator(VersionTimeBased)
+    seen := make(map[UUID]bool)
+    for i := 0; i < 1000; i++ {
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.False(t, seen[uuid])
+        seen[uuid] = true
+    }
+}
+
+func TestTime(t *testing.T) {
+    tests := []struct {
+        name  string
+        input string
+        want  time.Time
+    }{
+        // Test vectors from RFC 9562 appendix A
+        {"v1", "c232ab00-9414-11ec-b3c8-9f6bdeced846", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)},
+        {"v6", "1ec9414c-232a-6b00-b3c8-9f6bdeced846", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)},
+        {"v7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := MustParse(tt.input).Time()
+            require.NoError(t, err)
+            assert.True(t, tt.want.Equal(got), "got %v", got)
+        })
+    }
+}
+
+func TestTimeGenerated(t *testing.T) {
+    before := time.Now().Add(-time.Millisecond)
+    for _, version := range []Version{VersionTimeBased, VersionReorderedTime} {
+        uuid, err := MustNewGenerator(version).Generate()
+        require.NoError(t, err)
+        got, err := uuid.Time()
+        require.NoError(t, err)
+        assert.WithinDuration(t, before, got, time.Second)
+    }
+}
+
+func TestTimeUnsupportedVersion(t *testing.T) {
+    _, err := New().Time()
+    assert.Error(t, err)
+}
+
+func TestToV6(t *testing.T) {
+    // Test vectors from RFC 9562 appendix A share a timestamp, clock
+    // sequence and node
+    v1 := MustParse("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+    v6 := MustParse("1ec9414c-232a-6b00-b3c8-9f6bdeced846")
+
+    got, err := v1.ToV6()
+    require.NoError(t, err)
+    assert.Equal(t, v6, got)
+
+    back, err := got.ToV1()
+    require.NoError(t, err)
+    assert.Equal(t, v1, back)
+}
+
+func TestToV6RoundTrip(t *testing.T) {
+    for i := 0; i < 100; i++ {
+        v1 := Must(NewV1())
+        v6, err := v1.ToV6()
+        require.NoError(t, err)
+        assert.Equal(t, VersionReorderedTime, v6.Version())
+        assert.Equal(t, v1.ClockSequence(), v6.ClockSequence())
+        assert.Equal(t, v1.NodeID(), v6.NodeID())
+        assert.Equal(t, v1, Must(v6.ToV1()))
+    }
+}
+
+func TestToV6WrongVersion(t *testing.T) {
+    _, err := New().ToV6()
+    assert.Error(t, err)
+    _, err = Must(NewV1()).ToV1()
+    assert.Error(t, err)
+}
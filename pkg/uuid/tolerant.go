@@ -0,0 +1,130 @@
+package uuid
+
+import (
+    "fmt"
+    "strings"
+    "unicode"
+)
+
+// Deviation is a recoverable departure from the canonical UUID form found
+// by TolerantParse
+type Deviation int
+
+const (
+    // DeviationWhitespace is leading, trailing or embedded whitespace
+    DeviationWhitespace Deviation = iota + 1
+    // DeviationQuotes is a value wrapped in single or double quotes
+    DeviationQuotes
+    // DeviationURN is a urn:uuid: prefix
+    DeviationURN
+    // DeviationBraces is a value wrapped in {}
+    DeviationBraces
+    // DeviationUppercase is uppercase hex digits or prefix
+    DeviationUppercase
+    // DeviationMissingHyphens is the 32-digit form without hyphens
+    DeviationMissingHyphens
+    // DeviationMisplacedHyphens is hyphens in non-canonical positions,
+    // including doubled hyphens
+    DeviationMisplacedHyphens
+)
+
+var deviationNames = map[Deviation]string{
+    DeviationWhitespace:       "whitespace",
+    DeviationQuotes:           "quotes",
+    DeviationURN:              "urn-prefix",
+    DeviationBraces:           "braces",
+    DeviationUppercase:        "uppercase",
+    DeviationMissingHyphens:   "missing-hyphens",
+    DeviationMisplacedHyphens: "misplaced-hyphens",
+}
+
+func (d Deviation) String() string {
+    if name, ok := deviationNames[d]; ok {
+        return name
+    }
+    return fmt.Sprintf("deviation(%d)", int(d))
+}
+
+// TolerantParse accepts the malformed but recoverable UUID spellings found
+// in real-world data, such as stray whitespace, quotes, doubled hyphens or
+// an uppercase URN, and returns the UUID along with every deviation from
+// the canonical form it had to forgive, in a fixed order. It never panics;
+// input that cannot be recovered yields an error.
+func TolerantParse(s string) (UUID, []Deviation, error) {
+    var found [DeviationMisplacedHyphens + 1]bool
+
+    if strings.IndexFunc(s, unicode.IsSpace) >= 0 {
+        found[DeviationWhitespace] = true
+        s = strings.Map(func(r rune) rune {
+            if unicode.IsSpace(r) {
+                return -1
+            }
+            return r
+        }, s)
+    }
+
+    if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+        found[DeviationQuotes] = true
+        s = s[1 : len(s)-1]
+    }
+
+    if len(s) >= 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+        found[DeviationURN] = true
+        if s[:9] != "urn:uuid:" {
+            found[DeviationUppercase] = true
+        }
+        s = s[9:]
+    }
+
+    if len(s) >= 2 && s[0] == '{' && s[len(s)-1] == '}' {
+        found[DeviationBraces] = true
+        s = s[1 : len(s)-1]
+    }
+
+    var uuid UUID
+    digits := 0
+    canonical := len(s) == EncodedLen
+    for i := 0; i < len(s); i++ {
+        c := s[i]
+        if c == '-' {
+            if i != 8 && i != 13 && i != 18 && i != 23 {
+                canonical = false
+            }
+            continue
+        }
+        if i == 8 || i == 13 || i == 18 || i == 23 {
+            canonical = false
+        }
+
+        v, ok := fromHexChar(c)
+        if !ok {
+            return Nil, nil, &SyntaxError{Offset: i, Msg: fmt.Sprintf("bad character %q", c)}
+        }
+        if 'A' <= c && c <= 'F' {
+            found[DeviationUppercase] = true
+        }
+        if digits == 32 {
+            return Nil, nil, fmt.Errorf("%w: too many hex digits", ErrInvalidLength)
+        }
+        uuid[digits/2] |= v << (4 * uint(1-digits%2))
+        digits++
+    }
+
+    if digits != 32 {
+        return Nil, nil, fmt.Errorf("%w: %d hex digits", ErrInvalidLength, digits)
+    }
+    switch {
+    case len(s) == 32:
+        found[DeviationMissingHyphens] = true
+    case !canonical:
+        found[DeviationMisplacedHyphens] = true
+    }
+
+    var deviations []Deviation
+    for d, ok := range found {
+        if ok {
+            deviations = append(deviations, Deviation(d))
+        }
+    }
+    return uuid, deviations, nil
+}
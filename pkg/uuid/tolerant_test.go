@@ -0,0 +1,74 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestTolerantParse(t *testing.T) {
+    const want = "550e8400-e29b-41d4-a716-446655440000"
+    tests := []struct {
+        input      string
+        deviations []Deviation
+    }{
+        {want, nil},
+        {"  " + want + "\n", []Deviation{DeviationWhitespace}},
+        {`"` + want + `"`, []Deviation{DeviationQuotes}},
+        {"URN:UUID:550E8400-E29B-41D4-A716-446655440000", []Deviation{DeviationURN, DeviationUppercase}},
+        {"{" + want + "}", []Deviation{DeviationBraces}},
+        {"550e8400e29b41d4a716446655440000", []Deviation{DeviationMissingHyphens}},
+        {"550e8400--e29b-41d4-a716-446655440000", []Deviation{DeviationMisplacedHyphens}},
+        {"550e-8400-e29b-41d4-a716-4466-5544-0000", []Deviation{DeviationMisplacedHyphens}},
+        {" 550e8400-e29b -41d4-a716-446655440000", []Deviation{DeviationWhitespace}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.input, func(t *testing.T) {
+            uuid, deviations, err := TolerantParse(tt.input)
+            require.NoError(t, err)
+            assert.Equal(t, want, uuid.String())
+            assert.Equal(t, tt.deviations, deviations)
+        })
+    }
+}
+
+func TestTolerantParseInvalid(t *testing.T) {
+    for _, input := range []string{
+        "",
+        "550e8400-e29b-41d4-a716",
+        "550e8400-e29b-41d4-a716-44665544000g",
+        "550e8400-e29b-41d4-a716-4466554400001",
+        "{550e8400-e29b-41d4-a716-446655440000",
+    } {
+        _, _, err := TolerantParse(input)
+        assert.Error(t, err, input)
+    }
+}
+
+func TestDeviationString(t *testing.T) {
+    assert.Equal(t, "misplaced-hyphens", DeviationMisplacedHyphens.String())
+    assert.Equal(t, "deviation(99)", Deviation(99).String())
+}
+
+func FuzzTolerantParse(f *testing.F) {
+    f.Add("550e8400-e29b-41d4-a716-446655440000")
+    f.Add(" urn:uuid:{550E8400e29b41d4a716446655440000} ")
+    f.Add("\"--\"")
+    f.Fuzz(func(t *testing.T, s string) {
+        uuid, deviations, err := TolerantParse(s)
+        if err != nil {
+            return
+        }
+        again, _, err := TolerantParse(uuid.String())
+        if err != nil || again != uuid {
+            t.Fatalf("canonical form of %q does not round-trip", s)
+        }
+        for _, d := range deviations {
+            if d < DeviationWhitespace || d > DeviationMisplacedHyphens {
+                t.Fatalf("unknown deviation %d", d)
+            }
+        }
+    })
+}
@@ -0,0 +1,127 @@
+package uuid
+
+import (
+    "fmt"
+    "strings"
+)
+
+// maxTypeIDPrefix is the longest prefix allowed by the TypeID spec
+const maxTypeIDPrefix = 63
+
+// TypeID is a self-describing identifier in the TypeID format: a lowercase
+// type prefix, an underscore and the UUID as 26 lowercase Crockford base32
+// characters, e.g. "user_01h455vb4pex5vsknk084sn02q". The prefix may be
+// empty, in which case the underscore is omitted too.
+type TypeID struct {
+    prefix string
+    uuid   UUID
+}
+
+// NewTypeID returns a TypeID with the given prefix and a new V7 UUID
+func NewTypeID(prefix string) (TypeID, error) {
+    if err := validateTypeIDPrefix(prefix); err != nil {
+        return TypeID{}, err
+    }
+    uuid, err := NewV7()
+    if err != nil {
+        return TypeID{}, err
+    }
+    return TypeID{prefix: prefix, uuid: uuid}, nil
+}
+
+// TypeIDFrom returns a TypeID with the given prefix and UUID. The spec
+// recommends V7 UUIDs but any UUID can be encoded.
+func TypeIDFrom(prefix string, u UUID) (TypeID, error) {
+    if err := validateTypeIDPrefix(prefix); err != nil {
+        return TypeID{}, err
+    }
+    return TypeID{prefix: prefix, uuid: u}, nil
+}
+
+// ParseTypeID parses a TypeID string
+func ParseTypeID(s string) (TypeID, error) {
+    prefix, suffix := "", s
+    if i := strings.LastIndexByte(s, '_'); i >= 0 {
+        prefix, suffix = s[:i], s[i+1:]
+        if prefix == "" {
+            return TypeID{}, fmt.Errorf("invalid TypeID %q: empty prefix with separator", s)
+        }
+    }
+    if err := validateTypeIDPrefix(prefix); err != nil {
+        return TypeID{}, err
+    }
+    for i := 0; i < len(suffix); i++ {
+        if c := suffix[i]; c >= 'A' && c <= 'Z' {
+            return TypeID{}, fmt.Errorf("invalid TypeID %q: suffix must be lowercase", s)
+        }
+    }
+    uuid, err := decodeULID(suffix)
+    if err != nil {
+        return TypeID{}, fmt.Errorf("invalid TypeID %q: %w", s, err)
+    }
+    return TypeID{prefix: prefix, uuid: uuid}, nil
+}
+
+// MustParseTypeID is like ParseTypeID but panics if error occurs
+func MustParseTypeID(s string) TypeID {
+    t, err := ParseTypeID(s)
+    if err != nil {
+        panic(err)
+    }
+    return t
+}
+
+// Prefix returns the type prefix
+func (t TypeID) Prefix() string {
+    return t.prefix
+}
+
+// UUID returns the underlying UUID
+func (t TypeID) UUID() UUID {
+    return t.uuid
+}
+
+// String returns the TypeID in its text form
+func (t TypeID) String() string {
+    suffix := strings.ToLower(t.uuid.ToULID())
+    if t.prefix == "" {
+        return suffix
+    }
+    return t.prefix + "_" + suffix
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t TypeID) MarshalText() ([]byte, error) {
+    return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *TypeID) UnmarshalText(text []byte) error {
+    parsed, err := ParseTypeID(string(text))
+    if err != nil {
+        return err
+    }
+    *t = parsed
+    return nil
+}
+
+// validateTypeIDPrefix checks a prefix against the TypeID spec: at most 63
+// lowercase ASCII letters and underscores, not starting or ending with an
+// underscore
+func validateTypeIDPrefix(prefix string) error {
+    if len(prefix) > maxTypeIDPrefix {
+        return fmt.Errorf("invalid TypeID prefix: longer than %d characters", maxTypeIDPrefix)
+    }
+    if prefix == "" {
+        return nil
+    }
+    if prefix[0] == '_' || prefix[len(prefix)-1] == '_' {
+        return fmt.Errorf("invalid TypeID prefix %q: starts or ends with an underscore", prefix)
+    }
+    for i := 0; i < len(prefix); i++ {
+        if c := prefix[i]; (c < 'a' || c > 'z') && c != '_' {
+            return fmt.Errorf("invalid TypeID prefix %q: invalid character %q", prefix, c)
+        }
+    }
+    return nil
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import (
+    "encoding/json"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestTypeIDSpecVectors(t *testing.T) {
+    // Valid cases from the TypeID specification
+    for _, tc := range []struct {
+        typeid string
+        prefix string
+        uuid   string
+    }{
+        {"00000000000000000000000000", "", "00000000-0000-0000-0000-000000000000"},
+        {"00000000000000000000000001", "", "00000000-0000-0000-0000-000000000001"},
+        {"7zzzzzzzzzzzzzzzzzzzzzzzzz", "", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+        {"prefix_0123456789abcdefghjkmnpqrs", "prefix", "0110c853-1d09-52d8-d73e-1194e95b5f19"},
+        {"pre_fix_00000000000000000000000000", "pre_fix", "00000000-0000-0000-0000-000000000000"},
+        {"prefix_01h455vb4pex5vsknk084sn02q", "prefix", "01890a5d-ac96-774b-bcce-b302099a8057"},
+    } {
+        id, err := ParseTypeID(tc.typeid)
+        require.NoError(t, err, tc.typeid)
+        assert.Equal(t, tc.prefix, id.Prefix())
+        assert.Equal(t, tc.uuid, id.UUID().String())
+        assert.Equal(t, tc.typeid, id.String())
+    }
+}
+
+func TestParseTypeIDInvalid(t *testing.T) {
+    for _, s := range []string{
+        "PREFIX_00000000000000000000000000",
+        "12345_00000000000000000000000000",
+        "_00000000000000000000000000",
+        "_prefix_00000000000000000000000000",
+        "prefix__00000000000000000000000000",
+        "prefix_",
+        "prefix_1234567890123456789012345",
+        "prefix_123456789012345678901234567",
+        "prefix_0123456789ABCDEFGHJKMNPQRS",
+        "prefix_ooooooiiiiiiuuuuuuulllllll",
+        "prefix_8zzzzzzzzzzzzzzzzzzzzzzzzz",
+        strings.Repeat("a", 64) + "_00000000000000000000000000",
+    } {
+        _, err := ParseTypeID(s)
+        assert.Error(t, err, s)
+    }
+}
+
+func TestNewTypeID(t *testing.T) {
+    id, err := NewTypeID("user")
+    require.NoError(t, err)
+    assert.Equal(t, "user", id.Prefix())
+    assert.Equal(t, VersionUnixTime, id.UUID().Version())
+    assert.True(t, strings.HasPrefix(id.String(), "user_"))
+
+    _, err = NewTypeID("User")
+    assert.Error(t, err)
+    _, err = TypeIDFrom("user_", Nil)
+    assert.Error(t, err)
+}
+
+func TestTypeIDJSON(t *testing.T) {
+    id := MustParseTypeID("user_01h455vb4pex5vsknk084sn02q")
+    data, err := json.Marshal(id)
+    require.NoError(t, err)
+    assert.Equal(t, `"user_01h455vb4pex5vsknk084sn02q"`, string(data))
+
+    var back TypeID
+    require.NoError(t, json.Unmarshal(data, &back))
+    assert.Equal(t, id, back)
+    assert.Error(t, json.Unmarshal([]byte(`"User_01h455vb4pex5vsknk084sn02q"`), &back))
+}
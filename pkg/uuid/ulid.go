@@ -0,0 +1,85 @@
+package uuid
+
+import "fmt"
+
+// ULIDLen is the length of the Crockford base32 text form of a ULID
+const ULIDLen = 26
+
+// crockford is the Crockford base32 alphabet used by ULIDs
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordValues maps an upper or lowercase Crockford base32 character to
+// its value, or 0xff if it is not part of the alphabet
+var crockfordValues = func() [256]byte {
+    var t [256]byte
+    for i := range t {
+        t[i] = 0xff
+    }
+    for i := 0; i < len(crockford); i++ {
+        t[crockford[i]] = byte(i)
+        if c := crockford[i]; c >= 'A' && c <= 'Z' {
+            t[c+'a'-'A'] = byte(i)
+        }
+    }
+    return t
+}()
+
+// ToULID encodes the 128 bits of u as a 26-character ULID. ULIDs and V7
+// UUIDs both begin with a 48-bit Unix millisecond timestamp, so the ULID of
+// a V7 UUID carries the same time.
+func (u UUID) ToULID() string {
+    var dst [ULIDLen]byte
+    // The first character holds the top 3 bits, then each following
+    // character holds the next 5 bits of the 128-bit value
+    hi := uint64(u[0])<<56 | uint64(u[1])<<48 | uint64(u[2])<<40 | uint64(u[3])<<32 |
+        uint64(u[4])<<24 | uint64(u[5])<<16 | uint64(u[6])<<8 | uint64(u[7])
+    lo := uint64(u[8])<<56 | uint64(u[9])<<48 | uint64(u[10])<<40 | uint64(u[11])<<32 |
+        uint64(u[12])<<24 | uint64(u[13])<<16 | uint64(u[14])<<8 | uint64(u[15])
+    for i := ULIDLen - 1; i >= 0; i-- {
+        dst[i] = crockford[lo&0x1f]
+        lo = lo>>5 | hi<<59
+        hi >>= 5
+    }
+    return string(dst[:])
+}
+
+// FromULID decodes a 26-character ULID into a V7 UUID. The timestamp is
+// kept as is; the version and variant bits overwrite 6 of the ULID's 80
+// random bits, so only ULIDs produced by ToULID from a V7 UUID round-trip
+// exactly.
+func FromULID(s string) (UUID, error) {
+    uuid, err := decodeULID(s)
+    if err != nil {
+        return Nil, err
+    }
+    uuid[6] = (uuid[6] & 0x0f) | 0x70 // Version 7
+    uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC4122
+    return uuid, nil
+}
+
+// decodeULID decodes the 128 bits of a ULID without altering them
+func decodeULID(s string) (UUID, error) {
+    if len(s) != ULIDLen {
+        return Nil, fmt.Errorf("%w: %d ULID characters", ErrInvalidLength, len(s))
+    }
+    if crockfordValues[s[0]] > 7 {
+        return Nil, fmt.Errorf("%w: ULID %q overflows 128 bits", ErrInvalidFormat, s)
+    }
+
+    var hi, lo uint64
+    for i := 0; i < ULIDLen; i++ {
+        v := crockfordValues[s[i]]
+        if v == 0xff {
+            return Nil, &SyntaxError{Offset: i, Msg: fmt.Sprintf("invalid ULID character %q", s[i])}
+        }
+        hi = hi<<5 | lo>>59
+        lo = lo<<5 | uint64(v)
+    }
+
+    var uuid UUID
+    for i := 0; i < 8; i++ {
+        uuid[i] = byte(hi >> (56 - 8*i))
+        uuid[8+i] = byte(lo >> (56 - 8*i))
+    }
+    return uuid, nil
+}
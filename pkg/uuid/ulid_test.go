@@ -0,0 +1,61 @@
+package uuid
+
+import (
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestToULID(t *testing.T) {
+    assert.Equal(t, "00000000000000000000000000", Nil.ToULID())
+    assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", maxUUID.ToULID())
+
+    // 01563e3a-b5d3-d676-4c61-efb99302bd5b is the reference ULID
+    // 01ARZ3NDEKTSV4RRFFQ69G5FAV in hexadecimal
+    uuid := MustParse("01563e3a-b5d3-d676-4c61-efb99302bd5b")
+    assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", uuid.ToULID())
+}
+
+func TestULIDRoundTrip(t *testing.T) {
+    for i := 0; i < 100; i++ {
+        uuid, err := NewV7()
+        require.NoError(t, err)
+
+        s := uuid.ToULID()
+        assert.Len(t, s, ULIDLen)
+        back, err := FromULID(s)
+        require.NoError(t, err)
+        assert.Equal(t, uuid, back)
+
+        back, err = FromULID(strings.ToLower(s))
+        require.NoError(t, err)
+        assert.Equal(t, uuid, back)
+    }
+}
+
+func TestFromULID(t *testing.T) {
+    uuid, err := FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+    require.NoError(t, err)
+    assert.Equal(t, VersionUnixTime, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+
+    ts, err := uuid.Time()
+    require.NoError(t, err)
+    assert.Equal(t, int64(0x01563e3ab5d3), ts.UnixMilli())
+}
+
+func TestFromULIDInvalid(t *testing.T) {
+    for _, s := range []string{
+        "",
+        "01ARZ3NDEKTSV4RRFFQ69G5FA",
+        "01ARZ3NDEKTSV4RRFFQ69G5FAVV",
+        "81ARZ3NDEKTSV4RRFFQ69G5FAV",
+        "01ARZ3NDEKTSV4RRFFQ69G5FAU",
+        "01ARZ3NDEKTSV4RRFFQ69G5FA-",
+    } {
+        _, err := FromULID(s)
+        assert.Error(t, err, s)
+    }
+}
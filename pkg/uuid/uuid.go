@@ -1,12 +1,11 @@
 package uuid
 
 import (
-    "crypto/rand"
     "database/sql/driver"
-    "encoding/hex"
     "encoding/json"
     "fmt"
-    "strings"
+    "io"
+    "sync"
     "time"
 )
 
@@ -27,6 +26,9 @@ const (
     VersionNameBasedMD5
     VersionRandom
     VersionNameBasedSHA1
+    VersionReorderedTime
+    VersionUnixTime
+    VersionCustom
 )
 
 const (
@@ -49,22 +51,135 @@ type Generator interface {
 // UUIDGenerator is the default UUID generator
 type UUIDGenerator struct {
     version Version
+    clock   Clock
+
+    // time-based state shared by V1 and V6
+    mu         sync.Mutex
+    node       [6]byte
+    nodeSet    bool
+    randomNode bool
+    clockSeq   uint16
+    seqSet     bool
+    lastTime   uint64
+
+    // V7 state: the last timestamp and counter, packed as ms<<12 | counter
+    lastV7      uint64
+    subMilli    bool
+    coordinator V7Coordinator
+
+    // V7 degraded mode, see WithDegradedMode
+    degrade      bool
+    stall        time.Duration
+    entropy      *entropyFeed
+    degradedSalt [6]byte
+    degraded     uint64
+
+    rand    io.Reader
+    profile *Profile
+
+    // clock readings, for regression tracking
+    lastClock      time.Time
+    lastRegression time.Time
+    regressions    uint64
+    regressing     bool
+    policy         RegressionPolicy
+    stats          *accounting
+    latency        LatencyObserver
+    hooks          *hookQueue
+    store          StateStore
+    stopWatch      func() bool
+
+    // name-based (V3 and V5) input
+    namespace UUID
+    name      string
+    nameSet   bool
 }
 
-// NewGenerator creates a new UUID generator for the specified version
-func NewGenerator(version Version) Generator {
-    return &UUIDGenerator{version: version}
+// Option configures a UUIDGenerator
+type Option func(*UUIDGenerator)
+
+// NewGenerator creates a new UUID generator for the specified version. It
+// supports versions 1 and 3 through 8; name-based versions (3 and 5) also
+// require the WithName option.
+func NewGenerator(version Version, opts ...Option) (*UUIDGenerator, error) {
+    g := &UUIDGenerator{version: version}
+    for _, opt := range opts {
+        opt(g)
+    }
+
+    switch version {
+    case VersionTimeBased, VersionRandom, VersionReorderedTime, VersionUnixTime, VersionCustom:
+    case VersionNameBasedMD5, VersionNameBasedSHA1:
+        if !g.nameSet {
+            return nil, fmt.Errorf("version %d generator requires a namespace and name", version)
+        }
+    default:
+        return nil, fmt.Errorf("unsupported UUID version: %d", version)
+    }
+
+    if g.degrade {
+        g.degradedSalt = newDegradedSalt()
+        if g.stall > 0 {
+            g.entropy = &entropyFeed{read: g.readRandom}
+            g.entropy.start()
+        }
+    }
+    if g.store != nil {
+        if err := g.restoreState(); err != nil {
+            return nil, err
+        }
+    }
+    if g.hooks != nil {
+        g.hooks.start()
+    }
+    return g, nil
+}
+
+// MustNewGenerator is like NewGenerator but panics if error occurs
+func MustNewGenerator(version Version, opts ...Option) *UUIDGenerator {
+    g, err := NewGenerator(version, opts...)
+    if err != nil {
+        panic(err)
+    }
+    return g
 }
 
 // Generate creates a new UUID based on the generator's version
 func (g *UUIDGenerator) Generate() (UUID, error) {
+    if g.latency != nil {
+        defer g.observeSince(StageGenerate, time.Now())
+    }
+    uuid, err := generateAllowed(g.generate)
+    if err == nil && g.profile != nil {
+        err = g.profile.Validate(uuid)
+    }
+    if err == nil && g.stats != nil {
+        g.stats.record(g.now())
+    }
+    if err == nil && g.hooks != nil {
+        g.hooks.publish(uuid)
+    }
+    return uuid, err
+}
+
+func (g *UUIDGenerator) generate() (UUID, error) {
     switch g.version {
     case VersionRandom:
-        return generateV4()
+        return g.generateV4()
     case VersionTimeBased:
-        return generateV1()
+        return g.generateV1()
+    case VersionReorderedTime:
+        return g.generateV6()
+    case VersionUnixTime:
+        return g.generateV7()
+    case VersionNameBasedMD5:
+        return NewV3(g.namespace, g.name), nil
+    case VersionNameBasedSHA1:
+        return NewV5(g.namespace, g.name), nil
+    case VersionCustom:
+        return g.generateV8()
     default:
-        return generateV4() // Default to V4
+        return Nil, fmt.Errorf("unsupported UUID version: %d", g.version)
     }
 }
 
@@ -73,20 +188,31 @@ func (g *UUIDGenerator) Version() Version {
     return g.version
 }
 
-// New generates a new random UUID (Version 4)
+// defaultRandomGenerator backs the package-level NewV4 function and is the
+// default generator unless replaced with SetDefault
+var defaultRandomGenerator = &UUIDGenerator{version: VersionRandom}
+
+// New generates a new UUID with the default generator, a random UUID
+// (Version 4) unless replaced with SetDefault. It returns Nil if
+// generation fails.
 func New() UUID {
-    uuid, _ := generateV4()
+    uuid, _ := Default().Generate()
     return uuid
 }
 
 // NewV4 generates a new random UUID (Version 4)
 func NewV4() (UUID, error) {
-    return generateV4()
+    return generateAllowed(defaultRandomGenerator.generateV4)
 }
 
 // NewV1 generates a new time-based UUID (Version 1)
 func NewV1() (UUID, error) {
-    return generateV1()
+    return generateAllowed(defaultTimeGenerator.generateV1)
+}
+
+// NewV6 generates a new reordered time-based UUID (Version 6)
+func NewV6() (UUID, error) {
+    return generateAllowed(defaultTimeGenerator.generateV6)
 }
 
 // Must is a helper that wraps a UUID generation function and panics if error occurs
@@ -97,31 +223,37 @@ func Must(uuid UUID, err error) UUID {
     return uuid
 }
 
-// MustNew generates a new UUID and panics if error occurs
+// MustNew generates a new UUID with the default generator and panics if
+// error occurs
 func MustNew() UUID {
-    return Must(NewV4())
+    return Must(Default().Generate())
 }
 
-// Parse parses a string into a UUID
+// Parse parses a string into a UUID. It accepts the canonical form, 32 hex
+// digits without hyphens, either in braces, and a "urn:uuid:" or "uuid:"
+// prefix as written by URN; hex digits and prefixes may be in either case.
+// Parse does not allocate unless it fails.
 func Parse(s string) (UUID, error) {
-    var uuid UUID
-    
-    // Remove hyphens and braces
-    s = strings.ReplaceAll(s, "-", "")
-    s = strings.ReplaceAll(s, "{", "")
-    s = strings.ReplaceAll(s, "}", "")
-    
-    if len(s) != 32 {
-        return uuid, fmt.Errorf("invalid UUID length: %d", len(s))
+    uuid, err := parseText(s)
+    if err != nil {
+        reportTextFailure(s, err)
     }
-    
-    decoded, err := hex.DecodeString(s)
+    return uuid, err
+}
+
+// ParseStrict parses only the canonical 8-4-4-4-12 form, with hex digits
+// in either case and hyphens in their fixed positions, for validating
+// external input that must not be in any of the other forms Parse accepts
+func ParseStrict(s string) (UUID, error) {
+    if len(s) != EncodedLen {
+        reportFailure(s, FailureLength)
+        return Nil, fmt.Errorf("%w: %d", ErrInvalidLength, len(s))
+    }
+    uuid, err := decodeCanonical(s, 0)
     if err != nil {
-        return uuid, fmt.Errorf("invalid UUID format: %v", err)
+        reportFailure(s, FailureFormat)
     }
-    
-    copy(uuid[:], decoded)
-    return uuid, nil
+    return uuid, err
 }
 
 // MustParse parses a string into a UUID and panics if error occurs
@@ -137,21 +269,33 @@ func MustParse(s string) UUID {
 func ParseBytes(b []byte) (UUID, error) {
     var uuid UUID
     if len(b) != 16 {
-        return uuid, fmt.Errorf("invalid UUID byte length: %d", len(b))
+        return uuid, fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(b))
     }
     copy(uuid[:], b)
     return uuid, nil
 }
 
+// Canonicalize parses s in any form Parse accepts and returns the
+// lowercase canonical form, for normalizing user input before storage
+func Canonicalize(s string) (string, error) {
+    uuid, err := Parse(s)
+    if err != nil {
+        return "", err
+    }
+    return uuid.String(), nil
+}
+
 // FromString is an alias for Parse
 func FromString(s string) (UUID, error) {
     return Parse(s)
 }
 
-// String returns the string representation of the UUID
+// String returns the canonical lowercase 8-4-4-4-12 form of the UUID. The
+// returned string is its only allocation.
 func (u UUID) String() string {
-    return fmt.Sprintf("%x-%x-%x-%x-%x",
-        u[:4], u[4:6], u[6:8], u[8:10], u[10:])
+    var buf [EncodedLen]byte
+    encodeCanonical(buf[:], u)
+    return string(buf[:])
 }
 
 // URN returns the RFC 2141 URN form of the UUID
@@ -159,6 +303,12 @@ func (u UUID) URN() string {
     return "urn:uuid:" + u.String()
 }
 
+// BracedString returns the canonical form of the UUID in braces, as used
+// by the Windows registry and COM
+func (u UUID) BracedString() string {
+    return "{" + u.String() + "}"
+}
+
 // Bytes returns the UUID as a byte slice
 func (u UUID) Bytes() []byte {
     return u[:]
@@ -188,6 +338,12 @@ func (u UUID) IsNil() bool {
     return u == Nil
 }
 
+// IsZero is an alias of IsNil, so the omitzero JSON option and libraries
+// that check for an IsZero method treat the nil UUID as empty
+func (u UUID) IsZero() bool {
+    return u == Nil
+}
+
 // Equal returns true if u and other are equal
 func (u UUID) Equal(other UUID) bool {
     return u == other
@@ -208,13 +364,15 @@ func (u UUID) Compare(other UUID) int {
 
 // MarshalJSON implements json.Marshaler
 func (u UUID) MarshalJSON() ([]byte, error) {
-    return json.Marshal(u.String())
+    b, _ := u.AppendText(append(make([]byte, 0, EncodedLen+2), '"'))
+    return append(b, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler
 func (u *UUID) UnmarshalJSON(data []byte) error {
     var s string
     if err := json.Unmarshal(data, &s); err != nil {
+        reportFailure(string(data), FailureSyntax)
         return err
     }
     
@@ -227,9 +385,10 @@ func (u *UUID) UnmarshalJSON(data []byte) error {
     return nil
 }
 
-// MarshalText implements encoding.TextMarshaler
+// MarshalText implements encoding.TextMarshaler. It writes the canonical
+// form, in uppercase if set with SetUppercaseText.
 func (u UUID) MarshalText() ([]byte, error) {
-    return []byte(u.String()), nil
+    return u.AppendText(make([]byte, 0, EncodedLen))
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler
@@ -243,9 +402,31 @@ func (u *UUID) UnmarshalText(text []byte) error {
     return nil
 }
 
-// Value implements driver.Valuer for database operations
+// MarshalBinary implements encoding.BinaryMarshaler, returning the 16 raw
+// bytes, so gob and other binary encoders use the compact form
+func (u UUID) MarshalBinary() ([]byte, error) {
+    return u.AppendBinary(make([]byte, 0, Size))
+}
+
+// AppendBinary implements encoding.BinaryAppender, appending the 16 raw
+// bytes to b
+func (u UUID) AppendBinary(b []byte) ([]byte, error) {
+    return append(b, u[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (u *UUID) UnmarshalBinary(data []byte) error {
+    if len(data) != Size {
+        return fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(data))
+    }
+    copy(u[:], data)
+    return nil
+}
+
+// Value implements driver.Valuer for database operations. It returns the
+// canonical string unless changed with SetValueFormat.
 func (u UUID) Value() (driver.Value, error) {
-    return u.String(), nil
+    return u.ValueAs(ValueFormat(valueFormat.Load()))
 }
 
 // Scan implements sql.Scanner for database operations
@@ -273,6 +454,7 @@ func (u *UUID) Scan(value interface{}) error {
             *u = parsed
         }
     default:
+        reportFailure(fmt.Sprintf("%T", value), FailureType)
         return fmt.Errorf("cannot scan %T into UUID", value)
     }
     
@@ -280,9 +462,17 @@ func (u *UUID) Scan(value interface{}) error {
 }
 
 // Internal generation functions
-func generateV4() (UUID, error) {
+func (g *UUIDGenerator) generateV4() (UUID, error) {
     var uuid UUID
-    _, err := rand.Read(uuid[:])
+    var err error
+    if poolEnabled.Load() && g.rand == nil {
+        if g.latency != nil {
+            defer g.observeSince(StageEntropy, time.Now())
+        }
+        err = readPool(uuid[:])
+    } else {
+        err = g.readRandom(uuid[:])
+    }
     if err != nil {
         return uuid, err
     }
@@ -293,33 +483,3 @@ func generateV4() (UUID, error) {
     
     return uuid, nil
 }
-
-func generateV1() (UUID, error) {
-    var uuid UUID
-    _, err := rand.Read(uuid[:])
-    if err != nil {
-        return uuid, err
-    }
-    
-    // Simplified V1 generation (in real implementation, use proper timestamp and MAC)
-    now := time.Now().UnixNano()
-    
-    // Time low
-    uuid[0] = byte(now)
-    uuid[1] = byte(now >> 8)
-    uuid[2] = byte(now >> 16)
-    uuid[3] = byte(now >> 24)
-    
-    // Time mid
-    uuid[4] = byte(now >> 32)
-    uuid[5] = byte(now >> 40)
-    
-    // Time high and version
-    uuid[6] = byte(now>>48) & 0x0f
-    uuid[6] |= 0x10 // Version 1
-    
-    // Clock sequence and variant
-    uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC4122
-    
-    return uuid, nil
-}
\ No newline at end of file
@@ -1,7 +1,10 @@
 package uuid
 
 import (
+    "bytes"
+    "encoding/gob"
     "encoding/json"
+    "fmt"
     "strings"
     "testing"
     
@@ -51,6 +54,31 @@ func TestParse(t *testing.T) {
             input:   "{550e8400-e29b-41d4-a716-446655440000}",
             wantErr: false,
         },
+        {
+            name:    "valid URN",
+            input:   "urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+            wantErr: false,
+        },
+        {
+            name:    "valid uppercase URN",
+            input:   "URN:UUID:550E8400-E29B-41D4-A716-446655440000",
+            wantErr: false,
+        },
+        {
+            name:    "valid uuid: prefix",
+            input:   "uuid:550e8400-e29b-41d4-a716-446655440000",
+            wantErr: false,
+        },
+        {
+            name:    "prefix only",
+            input:   "urn:uuid:",
+            wantErr: true,
+        },
+        {
+            name:    "other URN namespace",
+            input:   "urn:oid:550e8400-e29b-41d4-a716-446655440000",
+            wantErr: true,
+        },
         {
             name:    "invalid UUID length",
             input:   "550e8400-e29b-41d4-a716",
@@ -76,6 +104,27 @@ func TestParse(t *testing.T) {
     }
 }
 
+func TestCanonicalize(t *testing.T) {
+    const want = "550e8400-e29b-41d4-a716-446655440000"
+    for _, s := range []string{
+        want,
+        "550E8400-E29B-41D4-A716-446655440000",
+        "550e8400e29b41d4a716446655440000",
+        "{550e8400-e29b-41d4-a716-446655440000}",
+        "urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+        "URN:UUID:550E8400-E29B-41D4-A716-446655440000",
+    } {
+        got, err := Canonicalize(s)
+        require.NoError(t, err, s)
+        assert.Equal(t, want, got, s)
+    }
+
+    for _, s := range []string{"", "urn:uuid:", "550e8400-e29b-41d4-a716", "urn:isbn:0451450523"} {
+        _, err := Canonicalize(s)
+        assert.Error(t, err, s)
+    }
+}
+
 func TestUUIDString(t *testing.T) {
     uuid := New()
     s := uuid.String()
@@ -90,6 +139,55 @@ func TestUUIDString(t *testing.T) {
     assert.Len(t, parts[4], 12)
 }
 
+func TestUUIDBracedString(t *testing.T) {
+    uuid := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    assert.Equal(t, "{550e8400-e29b-41d4-a716-446655440000}", uuid.BracedString())
+
+    parsed, err := Parse(uuid.BracedString())
+    require.NoError(t, err)
+    assert.Equal(t, uuid, parsed)
+}
+
+func TestParseStrict(t *testing.T) {
+    for _, s := range []string{
+        "550e8400-e29b-41d4-a716-446655440000",
+        "550E8400-E29B-41D4-A716-446655440000",
+    } {
+        uuid, err := ParseStrict(s)
+        require.NoError(t, err, s)
+        assert.Equal(t, MustParse(s), uuid)
+    }
+
+    for _, s := range []string{
+        "",
+        "5-5-0-e8400e29b41d4a716446655440000",
+        "550e8400e29b41d4a716446655440000",
+        "550e8400e29b-41d4-a716-446655440000-",
+        "{550e8400-e29b-41d4-a716-446655440000}",
+        "urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+        "550e8400-e29b-41d4-a716-44665544000g",
+    } {
+        _, err := ParseStrict(s)
+        assert.Error(t, err, s)
+    }
+}
+
+func TestURNRoundTrip(t *testing.T) {
+    uuid := New()
+    parsed, err := Parse(uuid.URN())
+    require.NoError(t, err)
+    assert.Equal(t, uuid, parsed)
+}
+
+func TestUUIDStringAllocs(t *testing.T) {
+    uuid := New()
+    allocs := testing.AllocsPerRun(100, func() {
+        _ = uuid.String()
+    })
+    assert.Equal(t, 1.0, allocs)
+    assert.Equal(t, fmt.Sprintf("%x-%x-%x-%x-%x", uuid[:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:]), uuid.String())
+}
+
 func TestUUIDEqual(t *testing.T) {
     uuid1 := New()
     uuid2 := New()
@@ -104,6 +202,15 @@ func TestUUIDIsNil(t *testing.T) {
     assert.False(t, New().IsNil())
 }
 
+func TestUUIDIsZero(t *testing.T) {
+    assert.True(t, Nil.IsZero())
+    assert.False(t, New().IsZero())
+
+    // Duck-typed by libraries that skip empty values
+    var z interface{ IsZero() bool } = UUID{}
+    assert.True(t, z.IsZero())
+}
+
 func TestUUIDJSON(t *testing.T) {
     uuid := New()
     
@@ -119,8 +226,30 @@ func TestUUIDJSON(t *testing.T) {
     assert.True(t, uuid.Equal(unmarshaled))
 }
 
+func TestUUIDBinary(t *testing.T) {
+    uuid := New()
+    data, err := uuid.MarshalBinary()
+    require.NoError(t, err)
+    assert.Equal(t, uuid[:], data)
+
+    var decoded UUID
+    require.NoError(t, decoded.UnmarshalBinary(data))
+    assert.Equal(t, uuid, decoded)
+
+    err = decoded.UnmarshalBinary(data[:15])
+    assert.ErrorIs(t, err, ErrInvalidLength)
+
+    // gob prefers the binary form over the text form
+    var buf bytes.Buffer
+    require.NoError(t, gob.NewEncoder(&buf).Encode(uuid))
+    assert.Contains(t, buf.String(), string(uuid[:]))
+    var fromGob UUID
+    require.NoError(t, gob.NewDecoder(&buf).Decode(&fromGob))
+    assert.Equal(t, uuid, fromGob)
+}
+
 func TestGenerator(t *testing.T) {
-    gen := NewGenerator(VersionRandom)
+    gen := MustNewGenerator(VersionRandom)
     assert.Equal(t, VersionRandom, gen.Version())
     
     uuid, err := gen.Generate()
@@ -128,6 +257,38 @@ func TestGenerator(t *testing.T) {
     assert.Equal(t, VersionRandom, uuid.Version())
 }
 
+func TestNewGeneratorVersions(t *testing.T) {
+    for _, version := range []Version{
+        VersionTimeBased, VersionRandom, VersionReorderedTime, VersionUnixTime, VersionCustom,
+    } {
+        gen, err := NewGenerator(version)
+        require.NoError(t, err)
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, version, uuid.Version())
+        assert.Equal(t, VariantRFC4122, uuid.Variant())
+    }
+
+    for _, version := range []Version{VersionNameBasedMD5, VersionNameBasedSHA1} {
+        _, err := NewGenerator(version)
+        assert.Error(t, err)
+
+        gen, err := NewGenerator(version, WithName(NamespaceDNS, "www.example.com"))
+        require.NoError(t, err)
+        uuid, err := gen.Generate()
+        require.NoError(t, err)
+        assert.Equal(t, version, uuid.Version())
+    }
+}
+
+func TestNewGeneratorUnsupported(t *testing.T) {
+    for _, version := range []Version{VersionUnknown, VersionDCESecurity, Version(9), Version(15)} {
+        _, err := NewGenerator(version)
+        assert.Error(t, err)
+    }
+    assert.Panics(t, func() { MustNewGenerator(VersionDCESecurity) })
+}
+
 func TestMust(t *testing.T) {
     uuid := Must(NewV4())
     assert.NotEqual(t, Nil, uuid)
@@ -143,12 +304,46 @@ func BenchmarkNew(b *testing.B) {
     }
 }
 
+func TestParseAllocs(t *testing.T) {
+    for _, s := range []string{
+        "550e8400-e29b-41d4-a716-446655440000",
+        "550e8400e29b41d4a716446655440000",
+        "urn:uuid:{550E8400-E29B-41D4-A716-446655440000}",
+    } {
+        allocs := testing.AllocsPerRun(100, func() {
+            if _, err := Parse(s); err != nil {
+                t.Fatal(err)
+            }
+        })
+        assert.Zero(t, allocs, s)
+    }
+}
+
 func BenchmarkParse(b *testing.B) {
     uuid := New()
     s := uuid.String()
     
+    b.ReportAllocs()
     b.ResetTimer()
     for i := 0; i < b.N; i++ {
         Parse(s)
     }
-}
\ No newline at end of file
+}
+
+func BenchmarkString(b *testing.B) {
+    uuid := New()
+    b.ReportAllocs()
+    for i := 0; i < b.N; i++ {
+        _ = uuid.String()
+    }
+}
+
+// BenchmarkStringSprintf is the fmt-based encoding String used to have, as
+// a baseline for BenchmarkString
+func BenchmarkStringSprintf(b *testing.B) {
+    uuid := New()
+    b.ReportAllocs()
+    for i := 0; i < b.N; i++ {
+        _ = fmt.Sprintf("%x-%x-%x-%x-%x", uuid[:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+    }
+}
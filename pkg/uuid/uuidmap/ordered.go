@@ -0,0 +1,142 @@
+// Package uuidmap provides maps keyed by UUIDs
+package uuidmap
+
+import (
+    "iter"
+    "sort"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// Ordered is a map from UUIDs to values of type V that keeps its keys in
+// byte order, supporting range scans by UUID bounds and, for V7 keys, by
+// time window. It is backed by a sorted slice: lookups and scans are fast
+// and allocation-free, while inserting or deleting a key in the middle
+// costs time linear in the size of the map, which suits read-heavy
+// indexes and keys inserted in increasing V7 order. An Ordered map is not
+// safe for concurrent use.
+type Ordered[V any] struct {
+    keys []uuid.UUID
+    vals []V
+}
+
+// New creates an empty ordered map
+func New[V any]() *Ordered[V] {
+    return &Ordered[V]{}
+}
+
+// search returns the index of the first key not less than k
+func (m *Ordered[V]) search(k uuid.UUID) int {
+    return sort.Search(len(m.keys), func(i int) bool {
+        return m.keys[i].Compare(k) >= 0
+    })
+}
+
+// Len returns the number of entries
+func (m *Ordered[V]) Len() int {
+    return len(m.keys)
+}
+
+// Get returns the value stored under k
+func (m *Ordered[V]) Get(k uuid.UUID) (V, bool) {
+    i := m.search(k)
+    if i < len(m.keys) && m.keys[i] == k {
+        return m.vals[i], true
+    }
+    var zero V
+    return zero, false
+}
+
+// Set stores v under k, replacing any previous value
+func (m *Ordered[V]) Set(k uuid.UUID, v V) {
+    // Appending in increasing order, the common case for V7 keys, skips
+    // the search
+    if n := len(m.keys); n == 0 || m.keys[n-1].Compare(k) < 0 {
+        m.keys = append(m.keys, k)
+        m.vals = append(m.vals, v)
+        return
+    }
+
+    i := m.search(k)
+    if m.keys[i] == k {
+        m.vals[i] = v
+        return
+    }
+    var zero V
+    m.keys = append(m.keys, uuid.Nil)
+    m.vals = append(m.vals, zero)
+    copy(m.keys[i+1:], m.keys[i:])
+    copy(m.vals[i+1:], m.vals[i:])
+    m.keys[i] = k
+    m.vals[i] = v
+}
+
+// Delete removes k, reporting whether it was present
+func (m *Ordered[V]) Delete(k uuid.UUID) bool {
+    i := m.search(k)
+    if i == len(m.keys) || m.keys[i] != k {
+        return false
+    }
+    copy(m.keys[i:], m.keys[i+1:])
+    copy(m.vals[i:], m.vals[i+1:])
+    var zero V
+    m.vals[len(m.vals)-1] = zero
+    m.keys = m.keys[:len(m.keys)-1]
+    m.vals = m.vals[:len(m.vals)-1]
+    return true
+}
+
+// All returns an iterator over all entries in key order
+func (m *Ordered[V]) All() iter.Seq2[uuid.UUID, V] {
+    return m.scan(0, len(m.keys))
+}
+
+// Range returns an iterator over the entries with keys in [from, to), in
+// key order
+func (m *Ordered[V]) Range(from, to uuid.UUID) iter.Seq2[uuid.UUID, V] {
+    start, end := m.search(from), m.search(to)
+    if end < start {
+        end = start
+    }
+    return m.scan(start, end)
+}
+
+// TimeRange returns an iterator over the entries whose V7 keys have a
+// timestamp in [start, end), at millisecond precision. Keys of other
+// versions whose leading 48 bits fall in the window are included too.
+func (m *Ordered[V]) TimeRange(start, end time.Time) iter.Seq2[uuid.UUID, V] {
+    return m.Range(timeBound(start), timeBound(end))
+}
+
+// scan iterates over the entries in [start, end). The map must not be
+// modified during iteration.
+func (m *Ordered[V]) scan(start, end int) iter.Seq2[uuid.UUID, V] {
+    return func(yield func(uuid.UUID, V) bool) {
+        for i := start; i < end && i < len(m.keys); i++ {
+            if !yield(m.keys[i], m.vals[i]) {
+                return
+            }
+        }
+    }
+}
+
+// timeBound returns the smallest UUID whose leading 48 bits are the Unix
+// millisecond timestamp of t, clamped to the representable range
+func timeBound(t time.Time) uuid.UUID {
+    var u uuid.UUID
+    ms := t.UnixMilli()
+    if ms < 0 {
+        return u
+    }
+    if ms >= 1<<48 {
+        for i := range u {
+            u[i] = 0xff
+        }
+        return u
+    }
+    for i := 0; i < 6; i++ {
+        u[i] = byte(ms >> (40 - 8*i))
+    }
+    return u
+}
@@ -0,0 +1,105 @@
+package uuidmap
+
+import (
+    "math/rand"
+    "sort"
+    "testing"
+    "time"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestOrdered(t *testing.T) {
+    m := New[int]()
+    var keys []uuid.UUID
+    for i := 0; i < 200; i++ {
+        k := uuid.New()
+        keys = append(keys, k)
+        m.Set(k, i)
+    }
+    assert.Equal(t, 200, m.Len())
+
+    for i, k := range keys {
+        v, ok := m.Get(k)
+        require.True(t, ok)
+        assert.Equal(t, i, v)
+    }
+
+    m.Set(keys[0], -1)
+    v, _ := m.Get(keys[0])
+    assert.Equal(t, -1, v)
+    assert.Equal(t, 200, m.Len())
+
+    sort.Slice(keys, func(i, j int) bool { return keys[i].Compare(keys[j]) < 0 })
+    var got []uuid.UUID
+    for k := range m.All() {
+        got = append(got, k)
+    }
+    assert.Equal(t, keys, got)
+
+    assert.True(t, m.Delete(keys[10]))
+    assert.False(t, m.Delete(keys[10]))
+    _, ok := m.Get(keys[10])
+    assert.False(t, ok)
+    assert.Equal(t, 199, m.Len())
+}
+
+func TestOrderedRange(t *testing.T) {
+    m := New[string]()
+    var keys []uuid.UUID
+    for i := 0; i < 10; i++ {
+        var k uuid.UUID
+        k[0] = byte(i * 10)
+        keys = append(keys, k)
+    }
+    for _, i := range rand.Perm(len(keys)) {
+        m.Set(keys[i], keys[i].String())
+    }
+
+    var got []uuid.UUID
+    for k, v := range m.Range(keys[2], keys[5]) {
+        assert.Equal(t, k.String(), v)
+        got = append(got, k)
+    }
+    assert.Equal(t, keys[2:5], got)
+
+    got = nil
+    for k := range m.Range(keys[5], keys[2]) {
+        got = append(got, k)
+    }
+    assert.Empty(t, got)
+
+    // Stopping early
+    n := 0
+    for range m.All() {
+        n++
+        if n == 3 {
+            break
+        }
+    }
+    assert.Equal(t, 3, n)
+}
+
+func TestOrderedTimeRange(t *testing.T) {
+    base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    m := New[int]()
+    for i := 0; i < 10; i++ {
+        ts := base.Add(time.Duration(i) * time.Minute)
+        gen := uuid.MustNewGenerator(uuid.VersionUnixTime, uuid.WithClock(uuid.ClockFunc(func() time.Time { return ts })))
+        m.Set(uuid.Must(gen.Generate()), i)
+    }
+
+    var got []int
+    for _, v := range m.TimeRange(base.Add(3*time.Minute), base.Add(6*time.Minute)) {
+        got = append(got, v)
+    }
+    assert.Equal(t, []int{3, 4, 5}, got)
+
+    got = nil
+    for _, v := range m.TimeRange(time.Unix(-1, 0), base.Add(time.Hour*1e6)) {
+        got = append(got, v)
+    }
+    assert.Len(t, got, 10)
+}
@@ -0,0 +1,137 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package uuidtest
+
+import (
+	uuid "github.com/Wembie/uuid/pkg/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GeneratorMock is an autogenerated mock type for the Generator type
+type GeneratorMock struct {
+	mock.Mock
+}
+
+type GeneratorMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GeneratorMock) EXPECT() *GeneratorMock_Expecter {
+	return &GeneratorMock_Expecter{mock: &_m.Mock}
+}
+
+// Generate provides a mock function with no fields
+func (_m *GeneratorMock) Generate() (uuid.UUID, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Generate")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uuid.UUID, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uuid.UUID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GeneratorMock_Generate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Generate'
+type GeneratorMock_Generate_Call struct {
+	*mock.Call
+}
+
+// Generate is a helper method to define mock.On call
+func (_e *GeneratorMock_Expecter) Generate() *GeneratorMock_Generate_Call {
+	return &GeneratorMock_Generate_Call{Call: _e.mock.On("Generate")}
+}
+
+func (_c *GeneratorMock_Generate_Call) Run(run func()) *GeneratorMock_Generate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneratorMock_Generate_Call) Return(_a0 uuid.UUID, _a1 error) *GeneratorMock_Generate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GeneratorMock_Generate_Call) RunAndReturn(run func() (uuid.UUID, error)) *GeneratorMock_Generate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function with no fields
+func (_m *GeneratorMock) Version() uuid.Version {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 uuid.Version
+	if rf, ok := ret.Get(0).(func() uuid.Version); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uuid.Version)
+	}
+
+	return r0
+}
+
+// GeneratorMock_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type GeneratorMock_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+func (_e *GeneratorMock_Expecter) Version() *GeneratorMock_Version_Call {
+	return &GeneratorMock_Version_Call{Call: _e.mock.On("Version")}
+}
+
+func (_c *GeneratorMock_Version_Call) Run(run func()) *GeneratorMock_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneratorMock_Version_Call) Return(_a0 uuid.Version) *GeneratorMock_Version_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneratorMock_Version_Call) RunAndReturn(run func() uuid.Version) *GeneratorMock_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGeneratorMock creates a new instance of GeneratorMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGeneratorMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GeneratorMock {
+	mock := &GeneratorMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,75 @@
+package uuidtest
+
+import (
+    "errors"
+    "sync"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// ErrExhausted is returned by MockGenerator once its script is used up
+var ErrExhausted = errors.New("mock generator: no scripted UUIDs left")
+
+// MockGenerator is a uuid.Generator that returns a scripted list of UUIDs
+// and records how many were requested. It is safe for concurrent use.
+type MockGenerator struct {
+    mu    sync.Mutex
+    ids   []uuid.UUID
+    err   error
+    next  int
+    calls int
+}
+
+// NewMockGenerator creates a MockGenerator returning ids in order
+func NewMockGenerator(ids ...uuid.UUID) *MockGenerator {
+    return &MockGenerator{ids: ids}
+}
+
+// Generate returns the next scripted UUID, the error set with SetError, or
+// ErrExhausted when the script is used up
+func (m *MockGenerator) Generate() (uuid.UUID, error) {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    m.calls++
+    if m.err != nil {
+        return uuid.Nil, m.err
+    }
+    if m.next >= len(m.ids) {
+        return uuid.Nil, ErrExhausted
+    }
+    m.next++
+    return m.ids[m.next-1], nil
+}
+
+// Version returns the version of the first scripted UUID
+func (m *MockGenerator) Version() uuid.Version {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    if len(m.ids) == 0 {
+        return uuid.VersionUnknown
+    }
+    return m.ids[0].Version()
+}
+
+// SetError makes every subsequent Generate call fail with err without
+// consuming the script; a nil err resumes it
+func (m *MockGenerator) SetError(err error) {
+    m.mu.Lock()
+    m.err = err
+    m.mu.Unlock()
+}
+
+// Calls returns the number of times Generate was called
+func (m *MockGenerator) Calls() int {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return m.calls
+}
+
+// Remaining returns the number of scripted UUIDs not yet returned
+func (m *MockGenerator) Remaining() int {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+    return len(m.ids) - m.next
+}
@@ -0,0 +1,59 @@
+package uuidtest
+
+import (
+    "errors"
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+)
+
+var (
+    _ uuid.Generator = (*MockGenerator)(nil)
+    _ uuid.Generator = (*GeneratorMock)(nil)
+)
+
+func TestMockGenerator(t *testing.T) {
+    a := uuid.MustParse("00000000-0000-7000-8000-000000000001")
+    b := uuid.MustParse("00000000-0000-7000-8000-000000000002")
+    m := NewMockGenerator(a, b)
+
+    assert.Equal(t, uuid.VersionUnixTime, m.Version())
+    assert.Equal(t, a, uuid.Must(m.Generate()))
+    assert.Equal(t, 1, m.Remaining())
+
+    boom := errors.New("boom")
+    m.SetError(boom)
+    _, err := m.Generate()
+    assert.Equal(t, boom, err)
+    m.SetError(nil)
+
+    assert.Equal(t, b, uuid.Must(m.Generate()))
+    _, err = m.Generate()
+    assert.Equal(t, ErrExhausted, err)
+    assert.Equal(t, 4, m.Calls())
+    assert.Equal(t, 0, m.Remaining())
+}
+
+func TestMockGeneratorEmpty(t *testing.T) {
+    m := NewMockGenerator()
+    assert.Equal(t, uuid.VersionUnknown, m.Version())
+    _, err := m.Generate()
+    assert.ErrorIs(t, err, ErrExhausted)
+}
+
+func TestGeneratorMock(t *testing.T) {
+    a := uuid.MustParse("00000000-0000-7000-8000-000000000001")
+    boom := errors.New("boom")
+
+    m := NewGeneratorMock(t)
+    m.EXPECT().Version().Return(uuid.VersionUnixTime)
+    m.EXPECT().Generate().Return(a, nil).Once()
+    m.EXPECT().Generate().Return(uuid.Nil, boom).Once()
+
+    assert.Equal(t, uuid.VersionUnixTime, m.Version())
+    assert.Equal(t, a, uuid.Must(m.Generate()))
+    _, err := m.Generate()
+    assert.Equal(t, boom, err)
+    m.AssertNumberOfCalls(t, "Generate", 2)
+}
@@ -0,0 +1,40 @@
+// Package uuidtest provides helpers for testing code that uses UUIDs
+package uuidtest
+
+//go:generate mockery --name Generator --dir .. --output . --outpkg uuidtest --structname GeneratorMock --filename generator_mock.go --with-expecter --disable-version-string
+
+import (
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+)
+
+// Equal reports a test failure with a field-by-field diff if got != want
+func Equal(t testing.TB, want, got uuid.UUID) bool {
+    t.Helper()
+    if want == got {
+        return true
+    }
+    t.Errorf("UUIDs differ (want, got):\n%s", uuid.DiffString(want, got))
+    return false
+}
+
+// NotEqual reports a test failure if got == want
+func NotEqual(t testing.TB, want, got uuid.UUID) bool {
+    t.Helper()
+    if want != got {
+        return true
+    }
+    t.Errorf("UUIDs should differ: %s", got)
+    return false
+}
+
+// Version reports a test failure if u does not have the given version
+func Version(t testing.TB, want uuid.Version, u uuid.UUID) bool {
+    t.Helper()
+    if u.Version() == want {
+        return true
+    }
+    t.Errorf("UUID %s has version %d, want %d", u, u.Version(), want)
+    return false
+}
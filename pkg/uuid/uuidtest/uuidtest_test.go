@@ -0,0 +1,45 @@
+package uuidtest
+
+import (
+    "fmt"
+    "strings"
+    "testing"
+
+    "github.com/Wembie/uuid/pkg/uuid"
+    "github.com/stretchr/testify/assert"
+)
+
+// recorder captures failures reported through testing.TB
+type recorder struct {
+    testing.TB
+    messages []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+    r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func TestEqual(t *testing.T) {
+    a := uuid.New()
+    b := uuid.New()
+
+    r := &recorder{TB: t}
+    assert.True(t, Equal(r, a, a))
+    assert.Empty(t, r.messages)
+
+    assert.False(t, Equal(r, a, b))
+    assert.Len(t, r.messages, 1)
+    assert.True(t, strings.Contains(r.messages[0], "! uuid"))
+}
+
+func TestNotEqualAndVersion(t *testing.T) {
+    a := uuid.New()
+    r := &recorder{TB: t}
+
+    assert.False(t, NotEqual(r, a, a))
+    assert.True(t, Version(r, uuid.VersionRandom, a))
+    assert.False(t, Version(r, uuid.VersionUnixTime, a))
+    assert.Len(t, r.messages, 2)
+}
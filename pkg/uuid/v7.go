@@ -0,0 +1,92 @@
+package uuid
+
+// defaultUnixGenerator backs the package-level NewV7 function
+var defaultUnixGenerator = &UUIDGenerator{version: VersionUnixTime}
+
+// WithSubMillisecondPrecision makes a V7 generator fill rand_a with the
+// sub-millisecond fraction of the timestamp (RFC 9562 section 6.2, method
+// 3) instead of a random-seeded counter, giving roughly 244ns ordering
+// precision across generators. UUIDs from one generator remain strictly
+// increasing: a value that would not sort after the previous one is
+// replaced by its successor.
+func WithSubMillisecondPrecision() Option {
+    return func(g *UUIDGenerator) {
+        g.subMilli = true
+    }
+}
+
+// NewV7 generates a new Unix time-ordered UUID (Version 7)
+func NewV7() (UUID, error) {
+    return generateAllowed(defaultUnixGenerator.generateV7)
+}
+
+// generateV7 builds a V7 UUID using the fixed-length dedicated counter
+// method of RFC 9562 section 6.2. The 12-bit rand_a field holds a counter
+// that is seeded randomly, with its top bit clear to leave room for
+// increments, on every new millisecond and incremented for each UUID
+// generated within the same millisecond. When the counter overflows the
+// carry advances the timestamp, so UUIDs from one generator are always
+// strictly increasing, even if the wall clock steps backwards.
+func (g *UUIDGenerator) generateV7() (UUID, error) {
+    var uuid UUID
+    degraded := false
+    if err := g.readV7Entropy(uuid[:]); err != nil {
+        if !g.degrade {
+            return uuid, err
+        }
+        uuid, degraded = Nil, true
+    }
+
+    g.mu.Lock()
+    t, err := g.readClock()
+    if err != nil {
+        g.mu.Unlock()
+        return Nil, err
+    }
+    nanos := t.UnixNano()
+    ms := uint64(nanos / 1e6)
+
+    var next uint64
+    if g.subMilli {
+        frac := uint64(nanos%1e6) << 12 / 1e6
+        next = ms<<12 | frac
+        if next <= g.lastV7 {
+            next = g.lastV7 + 1
+        }
+    } else {
+        seed := uint64(uuid[6]&0x07)<<8 | uint64(uuid[7])
+        next = ms<<12 | seed
+        if ms <= g.lastV7>>12 {
+            next = g.lastV7 + 1
+        }
+    }
+    if g.coordinator != nil {
+        next, err = g.coordinator.Allocate(next)
+        if err != nil {
+            g.mu.Unlock()
+            return Nil, err
+        }
+    }
+    g.lastV7 = next
+    putV7Fields(&uuid, next)
+    if degraded {
+        g.markDegraded(&uuid)
+    }
+    g.mu.Unlock()
+    return uuid, nil
+}
+
+// putV7Fields writes the 48-bit timestamp and 12-bit rand_a packed in
+// fields, along with the version and variant bits
+func putV7Fields(uuid *UUID, fields uint64) {
+    ms := fields >> 12
+    uuid[0] = byte(ms >> 40)
+    uuid[1] = byte(ms >> 32)
+    uuid[2] = byte(ms >> 24)
+    uuid[3] = byte(ms >> 16)
+    uuid[4] = byte(ms >> 8)
+    uuid[5] = byte(ms)
+    uuid[6] = byte(fields>>8)&0x0f | 0x70 // Version 7
+    uuid[7] = byte(fields)
+    uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC4122
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestNewV7(t *testing.T) {
+    uuid, err := NewV7()
+    require.NoError(t, err)
+    assert.Equal(t, VersionUnixTime, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+
+    ts, err := uuid.Time()
+    require.NoError(t, err)
+    assert.WithinDuration(t, time.Now(), ts, time.Second)
+}
+
+func TestV7Monotonic(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime)
+    prev, err := gen.Generate()
+    require.NoError(t, err)
+
+    for i := 0; i < 10000; i++ {
+        next, err := gen.Generate()
+        require.NoError(t, err)
+        require.Equal(t, 1, next.Compare(prev), "%s not after %s", next, prev)
+        prev = next
+    }
+}
+
+func TestV7CounterOverflowCarries(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime)
+    future := uint64(time.Now().Add(time.Hour).UnixMilli())
+    gen.lastV7 = future<<12 | 0xfff
+
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+    assert.Equal(t, (future+1)<<12, gen.lastV7)
+
+    ts, err := uuid.Time()
+    require.NoError(t, err)
+    assert.Equal(t, int64(future+1), ts.UnixMilli())
+}
+
+func TestV7SubMillisecondPrecision(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime, WithSubMillisecondPrecision())
+    prev, err := gen.Generate()
+    require.NoError(t, err)
+
+    for i := 0; i < 10000; i++ {
+        next, err := gen.Generate()
+        require.NoError(t, err)
+        require.Equal(t, 1, next.Compare(prev))
+        prev = next
+    }
+
+    ts, err := prev.Time()
+    require.NoError(t, err)
+    assert.WithinDuration(t, time.Now(), ts, time.Second)
+}
+
+func TestV7SubMillisecondFraction(t *testing.T) {
+    gen := MustNewGenerator(VersionUnixTime, WithSubMillisecondPrecision())
+    before := time.Now()
+    uuid, err := gen.Generate()
+    require.NoError(t, err)
+
+    // rand_a holds the fraction, so it must be within a tick of the clock
+    frac := int64(uuid[6]&0x0f)<<8 | int64(uuid[7])
+    nanos := frac * 1e6 >> 12
+    ts, err := uuid.Time()
+    require.NoError(t, err)
+    assert.WithinDuration(t, before, ts.Add(time.Duration(nanos)), 10*time.Millisecond)
+}
@@ -0,0 +1,20 @@
+package uuid
+
+// NewV8 builds a custom UUID (Version 8) from application-defined data,
+// overwriting only the version and variant bits
+func NewV8(data [16]byte) UUID {
+    uuid := UUID(data)
+    uuid[6] = (uuid[6] & 0x0f) | 0x80 // Version 8
+    uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC4122
+    return uuid
+}
+
+// generateV8 fills a custom UUID with random data, for generators that
+// need the version 8 marker without a specific custom layout
+func (g *UUIDGenerator) generateV8() (UUID, error) {
+    var data [16]byte
+    if err := g.readRandom(data[:]); err != nil {
+        return Nil, err
+    }
+    return NewV8(data), nil
+}
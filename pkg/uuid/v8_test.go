@@ -0,0 +1,18 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestNewV8(t *testing.T) {
+    var data [16]byte
+    for i := range data {
+        data[i] = 0xff
+    }
+    uuid := NewV8(data)
+    assert.Equal(t, "ffffffff-ffff-8fff-bfff-ffffffffffff", uuid.String())
+    assert.Equal(t, VersionCustom, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+}
@@ -0,0 +1,28 @@
+package uuid
+
+import "fmt"
+
+// Validate reports whether s is a canonical 8-4-4-4-12 UUID of the RFC 9562
+// variant, or the Nil or Max UUID. The error pinpoints the first problem,
+// such as the offset of a bad character or misplaced hyphen, so API layers
+// can return an actionable message.
+func Validate(s string) error {
+    if len(s) != EncodedLen {
+        return fmt.Errorf("%w: %d, want %d", ErrInvalidLength, len(s), EncodedLen)
+    }
+    // A stray hyphen shifts the groups after it, so report it before the
+    // hyphen it displaces
+    for i := 0; i < EncodedLen; i++ {
+        if s[i] == '-' && i != 8 && i != 13 && i != 18 && i != 23 {
+            return &SyntaxError{Offset: i, Msg: "misplaced hyphen"}
+        }
+    }
+    uuid, err := decodeCanonical(s, 0)
+    if err != nil {
+        return err
+    }
+    if uuid != Nil && uuid != maxUUID && uuid.Variant() != VariantRFC4122 {
+        return fmt.Errorf("%w: %s", ErrInvalidVariant, variantName(uuid.Variant()))
+    }
+    return nil
+}
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+    for _, s := range []string{
+        "550e8400-e29b-41d4-a716-446655440000",
+        "550E8400-E29B-41D4-A716-446655440000",
+        Nil.String(),
+        maxUUID.String(),
+    } {
+        assert.NoError(t, Validate(s), s)
+    }
+
+    tests := []struct {
+        input string
+        want  string
+    }{
+        {"", "invalid UUID length: 0, want 36"},
+        {"550e8400e29b41d4a716446655440000", "invalid UUID length: 32, want 36"},
+        {"550e8400-e29b-41d4-a716-44665544000g", `invalid UUID format: invalid character "g" at offset 35`},
+        {"550e8400-e29b-41d4-a716-4466554400é", `invalid UUID format: invalid character "\xc3" at offset 34`},
+        {"550e8400-e29b-41d4-a716-4466-5544000", "invalid UUID format: misplaced hyphen at offset 28"},
+        {"550e840-0e29b-41d4-a716-446655440000", "invalid UUID format: misplaced hyphen at offset 7"},
+        {"550e8400-e29b-41d4-a7160-46655440000", "invalid UUID format: misplaced hyphen at offset 24"},
+        {"550e8400_e29b-41d4-a716-446655440000", "invalid UUID format: expected hyphen at offset 8"},
+        {"00112233-4455-6677-c899-aabbccddeeff", "invalid UUID variant: Microsoft"},
+        {"00112233-4455-6677-0899-aabbccddeeff", "invalid UUID variant: NCS"},
+    }
+    for _, tt := range tests {
+        err := Validate(tt.input)
+        if assert.Error(t, err, tt.input) {
+            assert.Equal(t, tt.want, err.Error(), tt.input)
+        }
+    }
+}
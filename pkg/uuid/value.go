@@ -0,0 +1,53 @@
+package uuid
+
+import (
+    "database/sql/driver"
+    "fmt"
+    "sync/atomic"
+)
+
+// ValueFormat selects the representation of a UUID handed to a database
+// driver
+type ValueFormat int32
+
+const (
+    // ValueString is the canonical 36-character string, the default
+    ValueString ValueFormat = iota
+    // ValueBytes is the 16 raw bytes, for binary columns
+    ValueBytes
+    // ValueBraced is the canonical string in braces, as expected by some
+    // legacy GUID schemas
+    ValueBraced
+)
+
+// valueFormat is the package-wide format of UUID.Value
+var valueFormat atomic.Int32
+
+// SetValueFormat sets the representation UUID.Value returns for the whole
+// program. Types that need a fixed representation regardless of this
+// setting, such as BinaryUUID, call ValueAs instead. SetValueFormat is
+// safe for concurrent use.
+func SetValueFormat(f ValueFormat) error {
+    if f < ValueString || f > ValueBraced {
+        return fmt.Errorf("unknown value format: %d", f)
+    }
+    valueFormat.Store(int32(f))
+    return nil
+}
+
+// ValueAs returns u in the given representation, for driver.Valuer
+// implementations of application types wrapping UUID
+func (u UUID) ValueAs(f ValueFormat) (driver.Value, error) {
+    switch f {
+    case ValueString:
+        return u.String(), nil
+    case ValueBytes:
+        b := make([]byte, Size)
+        copy(b, u[:])
+        return b, nil
+    case ValueBraced:
+        return u.BracedString(), nil
+    default:
+        return nil, fmt.Errorf("unknown value format: %d", f)
+    }
+}
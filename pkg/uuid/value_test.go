@@ -0,0 +1,48 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestValueAs(t *testing.T) {
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+    for f, want := range map[ValueFormat]interface{}{
+        ValueString: "550e8400-e29b-41d4-a716-446655440000",
+        ValueBytes:  u[:],
+        ValueBraced: "{550e8400-e29b-41d4-a716-446655440000}",
+    } {
+        v, err := u.ValueAs(f)
+        require.NoError(t, err)
+        assert.Equal(t, want, v)
+
+        var back UUID
+        require.NoError(t, back.Scan(v))
+        assert.Equal(t, u, back)
+    }
+    _, err := u.ValueAs(ValueFormat(9))
+    assert.Error(t, err)
+}
+
+func TestSetValueFormat(t *testing.T) {
+    t.Cleanup(func() { _ = SetValueFormat(ValueString) })
+    u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+    require.NoError(t, SetValueFormat(ValueBytes))
+    v, err := u.Value()
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+
+    // Fixed-representation types ignore the package setting
+    require.NoError(t, SetValueFormat(ValueBraced))
+    v, err = BinaryUUID(u).Value()
+    require.NoError(t, err)
+    assert.Equal(t, u[:], v)
+
+    assert.Error(t, SetValueFormat(ValueFormat(-1)))
+    v, err = u.Value()
+    require.NoError(t, err)
+    assert.Equal(t, "{550e8400-e29b-41d4-a716-446655440000}", v)
+}
@@ -0,0 +1,273 @@
+package uuid
+
+import (
+    "bufio"
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+)
+
+// ExportFormat describes the layout of a dataset scanned by Verify
+type ExportFormat int
+
+const (
+    // ExportNDJSON is one JSON value per line
+    ExportNDJSON ExportFormat = iota
+    // ExportCSV is comma-separated records
+    ExportCSV
+    // ExportBinary is a stream of raw 16-byte UUIDs
+    ExportBinary
+)
+
+// maxVerifyLine bounds the length of a single NDJSON record
+const maxVerifyLine = 1 << 20
+
+// VerifyOptions configures Verify
+type VerifyOptions struct {
+    // Format is the layout of the input
+    Format ExportFormat
+    // Field is the NDJSON object key or CSV header column holding the UUID.
+    // If empty, NDJSON lines must be bare JSON strings and the first CSV
+    // column is used with no header row.
+    Field string
+    // Versions restricts the accepted versions; empty accepts any version
+    Versions []Version
+    // Monotonic requires every UUID to sort strictly after the previous
+    // one, as expected of a V7 stream
+    Monotonic bool
+    // MaxErrors stops the scan after that many errors; 0 means no limit
+    MaxErrors int
+}
+
+// VerifyError reports a single invalid record
+type VerifyError struct {
+    // Line is the 1-based line number, or record number for binary input
+    Line  int
+    Value string
+    Err   error
+}
+
+func (e VerifyError) Error() string {
+    return fmt.Sprintf("line %d: %q: %v", e.Line, e.Value, e.Err)
+}
+
+func (e VerifyError) Unwrap() error {
+    return e.Err
+}
+
+// VerifyReport summarizes a Verify run
+type VerifyReport struct {
+    Records int
+    Errors  []VerifyError
+}
+
+// Valid reports whether every record passed verification
+func (r VerifyReport) Valid() bool {
+    return len(r.Errors) == 0
+}
+
+var errStopVerify = errors.New("verify error limit reached")
+
+// verifier holds the running state of a Verify call
+type verifier struct {
+    opts   VerifyOptions
+    report VerifyReport
+    prev   UUID
+    seen   bool
+}
+
+// Verify scans an exported dataset and validates every UUID in it. Record
+// level problems are collected in the report; the returned error is only
+// non-nil when the input itself cannot be read.
+func Verify(r io.Reader, opts VerifyOptions) (VerifyReport, error) {
+    v := &verifier{opts: opts}
+
+    var err error
+    switch opts.Format {
+    case ExportNDJSON:
+        err = v.scanNDJSON(r)
+    case ExportCSV:
+        err = v.scanCSV(r)
+    case ExportBinary:
+        err = v.scanBinary(r)
+    default:
+        err = fmt.Errorf("unknown export format: %d", opts.Format)
+    }
+
+    if errors.Is(err, errStopVerify) {
+        err = nil
+    }
+    return v.report, err
+}
+
+func (v *verifier) scanNDJSON(r io.Reader) error {
+    scanner := bufio.NewScanner(r)
+    scanner.Buffer(make([]byte, 0, 64*1024), maxVerifyLine)
+
+    line := 0
+    for scanner.Scan() {
+        line++
+        raw := bytes.TrimSpace(scanner.Bytes())
+        if len(raw) == 0 {
+            continue
+        }
+        v.report.Records++
+
+        value, err := v.ndjsonValue(raw)
+        if err != nil {
+            if err := v.fail(line, string(raw), err); err != nil {
+                return err
+            }
+            continue
+        }
+        if err := v.check(line, value); err != nil {
+            return err
+        }
+    }
+    return scanner.Err()
+}
+
+func (v *verifier) ndjsonValue(raw []byte) (string, error) {
+    if v.opts.Field == "" {
+        var s string
+        err := json.Unmarshal(raw, &s)
+        return s, err
+    }
+
+    var obj map[string]json.RawMessage
+    if err := json.Unmarshal(raw, &obj); err != nil {
+        return "", err
+    }
+    field, ok := obj[v.opts.Field]
+    if !ok {
+        return "", fmt.Errorf("missing field %q", v.opts.Field)
+    }
+    var s string
+    err := json.Unmarshal(field, &s)
+    return s, err
+}
+
+func (v *verifier) scanCSV(r io.Reader) error {
+    cr := csv.NewReader(r)
+    cr.FieldsPerRecord = -1
+    cr.ReuseRecord = true
+
+    column := 0
+    if v.opts.Field != "" {
+        header, err := cr.Read()
+        if err == io.EOF {
+            return nil
+        }
+        if err != nil {
+            return err
+        }
+        column = -1
+        for i, name := range header {
+            if name == v.opts.Field {
+                column = i
+                break
+            }
+        }
+        if column < 0 {
+            return fmt.Errorf("missing column %q", v.opts.Field)
+        }
+    }
+
+    for {
+        record, err := cr.Read()
+        if err == io.EOF {
+            return nil
+        }
+        v.report.Records++
+        if err != nil {
+            var perr *csv.ParseError
+            if !errors.As(err, &perr) {
+                return err
+            }
+            if err := v.fail(perr.Line, "", perr.Err); err != nil {
+                return err
+            }
+            continue
+        }
+        line, _ := cr.FieldPos(0)
+        if column >= len(record) {
+            if err := v.fail(line, "", fmt.Errorf("missing column %d", column)); err != nil {
+                return err
+            }
+            continue
+        }
+        if err := v.check(line, record[column]); err != nil {
+            return err
+        }
+    }
+}
+
+func (v *verifier) scanBinary(r io.Reader) error {
+    br := bufio.NewReader(r)
+    var buf [16]byte
+    for record := 1; ; record++ {
+        n, err := io.ReadFull(br, buf[:])
+        if err == io.EOF {
+            return nil
+        }
+        v.report.Records++
+        if err == io.ErrUnexpectedEOF {
+            return v.fail(record, fmt.Sprintf("%x", buf[:n]), fmt.Errorf("truncated record of %d bytes", n))
+        }
+        if err != nil {
+            return err
+        }
+        if err := v.checkUUID(record, UUID(buf)); err != nil {
+            return err
+        }
+    }
+}
+
+// check parses and validates a textual record
+func (v *verifier) check(line int, value string) error {
+    uuid, err := Parse(value)
+    if err != nil {
+        return v.fail(line, value, err)
+    }
+    return v.checkUUID(line, uuid)
+}
+
+// checkUUID applies the deny list, version and ordering policies
+func (v *verifier) checkUUID(line int, uuid UUID) error {
+    if err := checkDenied(uuid); err != nil {
+        return v.fail(line, uuid.String(), err)
+    }
+    if len(v.opts.Versions) > 0 && !containsVersion(v.opts.Versions, uuid.Version()) {
+        return v.fail(line, uuid.String(), fmt.Errorf("%w: unexpected version %d", ErrInvalidVersion, uuid.Version()))
+    }
+
+    if v.opts.Monotonic {
+        if v.seen && uuid.Compare(v.prev) <= 0 {
+            v.prev = uuid
+            return v.fail(line, uuid.String(), fmt.Errorf("not after previous UUID"))
+        }
+        v.prev = uuid
+        v.seen = true
+    }
+    return nil
+}
+
+func (v *verifier) fail(line int, value string, err error) error {
+    v.report.Errors = append(v.report.Errors, VerifyError{Line: line, Value: value, Err: err})
+    if v.opts.MaxErrors > 0 && len(v.report.Errors) >= v.opts.MaxErrors {
+        return errStopVerify
+    }
+    return nil
+}
+
+func containsVersion(versions []Version, version Version) bool {
+    for _, v := range versions {
+        if v == version {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,81 @@
+package uuid
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestVerifyNDJSON(t *testing.T) {
+    input := `{"id":"550e8400-e29b-41d4-a716-446655440000"}
+{"id":"not-a-uuid"}
+
+{"name":"missing"}
+`
+    report, err := Verify(strings.NewReader(input), VerifyOptions{Format: ExportNDJSON, Field: "id"})
+    require.NoError(t, err)
+    assert.Equal(t, 3, report.Records)
+    require.Len(t, report.Errors, 2)
+    assert.Equal(t, 2, report.Errors[0].Line)
+    assert.Equal(t, 4, report.Errors[1].Line)
+}
+
+func TestVerifyCSV(t *testing.T) {
+    input := "name,id\na,550e8400-e29b-41d4-a716-446655440000\nb,6ba7b810-9dad-11d1-80b4-00c04fd430c8\n"
+    report, err := Verify(strings.NewReader(input), VerifyOptions{
+        Format:   ExportCSV,
+        Field:    "id",
+        Versions: []Version{VersionRandom},
+    })
+    require.NoError(t, err)
+    assert.Equal(t, 2, report.Records)
+    require.Len(t, report.Errors, 1)
+    assert.Equal(t, 3, report.Errors[0].Line)
+}
+
+func TestVerifyMalformedCSV(t *testing.T) {
+    tests := []struct {
+        input string
+        line  int
+    }{
+        {"\"abc\nx", 2},
+        {"a\"b\n", 1},
+        {"\"x\"y\n", 1},
+        {"550e8400-e29b-41d4-a716-446655440000\na\"b\n", 2},
+    }
+    for _, tt := range tests {
+        report, err := Verify(strings.NewReader(tt.input), VerifyOptions{Format: ExportCSV})
+        require.NoError(t, err, tt.input)
+        if assert.Len(t, report.Errors, 1, tt.input) {
+            assert.Equal(t, tt.line, report.Errors[0].Line, tt.input)
+        }
+    }
+}
+
+func TestVerifyBinaryMonotonic(t *testing.T) {
+    a := MustParse("00000000-0000-4000-8000-000000000001")
+    b := MustParse("00000000-0000-4000-8000-000000000002")
+
+    var buf bytes.Buffer
+    buf.Write(a[:])
+    buf.Write(b[:])
+    buf.Write(a[:])
+    buf.Write([]byte{1, 2, 3})
+
+    report, err := Verify(&buf, VerifyOptions{Format: ExportBinary, Monotonic: true})
+    require.NoError(t, err)
+    assert.Equal(t, 4, report.Records)
+    require.Len(t, report.Errors, 2)
+    assert.Equal(t, 3, report.Errors[0].Line)
+    assert.Equal(t, 4, report.Errors[1].Line)
+}
+
+func TestVerifyMaxErrors(t *testing.T) {
+    report, err := Verify(strings.NewReader("x\ny\nz\n"), VerifyOptions{Format: ExportCSV, MaxErrors: 2})
+    require.NoError(t, err)
+    assert.Len(t, report.Errors, 2)
+    assert.False(t, report.Valid())
+}
@@ -0,0 +1,48 @@
+package uuid
+
+import "fmt"
+
+// XIDLen is the length in bytes of an xid
+const XIDLen = 12
+
+// FromXID embeds a 12-byte xid in a V8 UUID. The xid bytes are laid out in
+// order around the version and variant fields and the remaining bits are
+// zero:
+//
+//	bytes 0-5   xid[0:6], the timestamp and start of the machine ID
+//	byte  6     version 8, low nibble zero
+//	byte  7     xid[6]
+//	byte  8     variant, low 6 bits zero
+//	byte  9     xid[7]
+//	bytes 10-13 xid[8:12], the end of the process ID and the counter
+//	bytes 14-15 zero
+//
+// UUIDs built this way sort in the same order as their xids. An xid.ID
+// from github.com/rs/xid can be passed directly.
+func FromXID(id [XIDLen]byte) UUID {
+    var uuid UUID
+    copy(uuid[0:6], id[0:6])
+    uuid[6] = 0x80 // Version 8
+    uuid[7] = id[6]
+    uuid[8] = 0x80 // Variant RFC4122
+    uuid[9] = id[7]
+    copy(uuid[10:14], id[8:12])
+    return uuid
+}
+
+// ToXID extracts the xid embedded by FromXID. It fails if u is not a V8
+// UUID with the zero padding of that layout.
+func (u UUID) ToXID() ([XIDLen]byte, error) {
+    var id [XIDLen]byte
+    if u.Version() != VersionCustom {
+        return id, fmt.Errorf("%w: version %d UUID %s does not embed an xid", ErrInvalidVersion, u.Version(), u)
+    }
+    if u[6] != 0x80 || u[8] != 0x80 || u[14] != 0 || u[15] != 0 {
+        return id, fmt.Errorf("%w: UUID %s does not embed an xid", ErrInvalidFormat, u)
+    }
+    copy(id[0:6], u[0:6])
+    id[6] = u[7]
+    id[7] = u[9]
+    copy(id[8:12], u[10:14])
+    return id, nil
+}
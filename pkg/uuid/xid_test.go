@@ -0,0 +1,43 @@
+package uuid
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestFromXID(t *testing.T) {
+    id := [XIDLen]byte{0x4d, 0x88, 0xe1, 0x5b, 0x60, 0xf4, 0x86, 0xe4, 0x28, 0x41, 0x2d, 0xc9}
+    uuid := FromXID(id)
+    assert.Equal(t, "4d88e15b-60f4-8086-80e4-28412dc90000", uuid.String())
+    assert.Equal(t, VersionCustom, uuid.Version())
+    assert.Equal(t, VariantRFC4122, uuid.Variant())
+
+    back, err := uuid.ToXID()
+    require.NoError(t, err)
+    assert.Equal(t, id, back)
+}
+
+func TestFromXIDOrder(t *testing.T) {
+    a := [XIDLen]byte{0x4d, 0x88, 0xe1, 0x5b, 0x60, 0xf4, 0x86, 0xe4, 0x28, 0x41, 0x2d, 0xc9}
+    b := a
+    b[11]++
+    c := a
+    c[6]++
+    assert.Equal(t, -1, FromXID(a).Compare(FromXID(b)))
+    assert.Equal(t, -1, FromXID(b).Compare(FromXID(c)))
+}
+
+func TestToXIDInvalid(t *testing.T) {
+    for _, uuid := range []UUID{
+        Nil,
+        MustParse("4d88e15b-60f4-4086-80e4-28412dc90000"),
+        MustParse("4d88e15b-60f4-8186-80e4-28412dc90000"),
+        MustParse("4d88e15b-60f4-8086-81e4-28412dc90000"),
+        MustParse("4d88e15b-60f4-8086-80e4-28412dc90001"),
+    } {
+        _, err := uuid.ToXID()
+        assert.Error(t, err, uuid.String())
+    }
+}